@@ -53,38 +53,31 @@ resource "linode_nodebalancer_node" "CNAME-www" {
 }
 
 resource "linode_domain" "kahoni-com" {
-   # the default type = "master" .. call it domain_type?
+   domain = "kahoni.com"
    soa_email = "admin@kahoni.com"
-   ttl_sec = "30"
-   expire_sec = "30"
-   refresh_sec = "30"
-   name = "kahoni.com"
-   # group              = "kahoni"
-   # interesting that the bare address "@" could be set this way..
-   # but terraform would have to do this behind the scenes
-   # ip_address = "${linode_instance.haproxy-www.ipv4_address}"
+   ttl_sec = "300"
+   expire_sec = "300"
+   refresh_sec = "300"
 }
 
 
-resource "linode_record" "A-@" {
-  domain = "${linode_domain.kahoni.id}"
-  type = "A"
-  name = "@"
-  values = ["${linode_nodebalancer.kahoni-nb.ipv4}"]
+resource "linode_domain_record" "A-apex" {
+  domain_id = "${linode_domain.kahoni-com.id}"
+  record_type = "A"
+  target = "${linode_nodebalancer.kahoni-nb.ipv4}"
 }
 
-resource "linode_record" "AAAA-@" {
-  domain = "${linode_domain.kahoni.id}"
-  type = "A"
-  name = "@"
-  values = ["${linode_nodebalancer.kahoni-nb.ipv6}"]
+resource "linode_domain_record" "AAAA-apex" {
+  domain_id = "${linode_domain.kahoni-com.id}"
+  record_type = "AAAA"
+  target = "${linode_nodebalancer.kahoni-nb.ipv6}"
 }
 
-resource "linode_record" "CNAME-www" {
-  domain = "${linode_domain.kahoni.id}"
-  type = "CNAME"
+resource "linode_domain_record" "CNAME-www" {
+  domain_id = "${linode_domain.kahoni-com.id}"
+  record_type = "CNAME"
   name = "www"
-  values = "@" # should get auto-upgraded to array, can "value" be an alias to values?
+  target = "kahoni.com"
 }
 
 resource "linode_instance" "nginx" {
@@ -0,0 +1,60 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeDomain() *schema.Resource {
+	// The attributes of the Domain mirror the arguments of the linode_domain resource
+	s := map[string]*schema.Schema{}
+	for k, v := range resourceLinodeDomain().Schema {
+		if k == "clone_from_domain_id" {
+			continue
+		}
+		attribute := *v
+		attribute.Required = false
+		attribute.Optional = false
+		attribute.Computed = true
+		attribute.Default = nil
+		attribute.ValidateFunc = nil
+		attribute.DiffSuppressFunc = nil
+		s[k] = &attribute
+	}
+	s["domain"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The domain the Domain represents, e.g. example.com.",
+		Required:    true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeDomainRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	name := strings.TrimSuffix(d.Get("domain").(string), ".")
+
+	filter, _ := json.Marshal(map[string]interface{}{"domain": name})
+	domains, err := client.ListDomains(context.TODO(), linodego.NewListOptions(0, string(filter)))
+	if err != nil {
+		return fmt.Errorf("Failed to list Linode Domains because %s", err)
+	}
+
+	for _, domain := range domains {
+		if strings.EqualFold(domain.Domain, name) {
+			d.SetId(fmt.Sprintf("%d", domain.ID))
+			syncDomainResourceData(d, domain)
+			return nil
+		}
+	}
+
+	return fmt.Errorf("Failed to find a Linode Domain for %s", name)
+}
@@ -0,0 +1,69 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeDomainRecord() *schema.Resource {
+	s := domainRecordComputedSchema()
+	s["domain_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The ID of the Domain the Record belongs to.",
+		Required:    true,
+	}
+	s["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The name of the Record. Leave empty for a Record of the Domain itself.",
+		Optional:    true,
+		Default:     "",
+	}
+	s["record_type"] = &schema.Schema{
+		Type:         schema.TypeString,
+		Description:  "The type of the Record: A, AAAA, NS, MX, CNAME, TXT, SRV or CAA.",
+		Required:     true,
+		ValidateFunc: validateDomainRecordType,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeDomainRecordRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+	name := d.Get("name").(string)
+	recordType := d.Get("record_type").(string)
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+
+	var matches []*linodego.DomainRecord
+	for _, record := range records {
+		if string(record.Type) == recordType && strings.EqualFold(record.Name, name) {
+			matches = append(matches, record)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return fmt.Errorf("Failed to find a %s record named %q in Linode Domain %d", recordType, name, domainID)
+	case 1:
+	default:
+		return fmt.Errorf("Found %d %s records named %q in Linode Domain %d, expected a single record", len(matches), recordType, name, domainID)
+	}
+
+	record := matches[0]
+	d.SetId(fmt.Sprintf("%d", record.ID))
+	syncDomainRecordResourceData(d, record)
+
+	return nil
+}
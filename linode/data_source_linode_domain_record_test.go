@@ -0,0 +1,43 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeDomainRecord(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_domain_record.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordDataSourceConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "id", "linode_domain_record.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "name", "www"),
+					resource.TestCheckResourceAttr(resName, "record_type", "A"),
+					resource.TestCheckResourceAttr(resName, "target", "192.0.2.1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeDomainRecordDataSourceConfig(domain string) string {
+	return testAccCheckLinodeDomainRecordConfigBasic(domain) + `
+
+data "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain_record.foobar.domain_id}"
+	name = "${linode_domain_record.foobar.name}"
+	record_type = "A"
+}`
+}
@@ -0,0 +1,43 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeDomain(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_domain.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainDataSourceConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "id", "linode_domain.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "domain", domainName),
+					resource.TestCheckResourceAttr(resName, "type", "master"),
+					resource.TestCheckResourceAttr(resName, "soa_email", "admin@"+domainName),
+					resource.TestCheckResourceAttr(resName, "status", "active"),
+					resource.TestCheckResourceAttr(resName, "ttl_sec", "300"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeDomainDataSourceConfig(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+data "linode_domain" "foobar" {
+	domain = "${linode_domain.foobar.domain}"
+}`
+}
@@ -0,0 +1,56 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeDomainZonefile() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeDomainZonefileRead,
+		Schema: map[string]*schema.Schema{
+			"domain_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the master Domain to render.",
+				Required:    true,
+			},
+			"domain": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The domain the Domain represents.",
+				Computed:    true,
+			},
+			"zone_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The Domain and its records rendered as a canonical, sorted BIND zone file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeDomainZonefileRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+
+	domain, err := client.GetDomain(context.TODO(), domainID)
+	if err != nil {
+		return fmt.Errorf("Failed to get Linode Domain %d because %s", domainID, err)
+	}
+	if domain.Type != linodego.DomainTypeMaster {
+		return fmt.Errorf("Failed to render Linode Domain %s because only master Domains have records", domain.Domain)
+	}
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%d", domainID))
+	d.Set("domain", domain.Domain)
+	d.Set("zone_file", renderZoneFile(domain, records))
+
+	return nil
+}
@@ -0,0 +1,41 @@
+package linode
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeDomainZonefile(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_domain_zonefile.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainZonefileDataSourceConfig(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "domain", domainName),
+					resource.TestMatchResourceAttr(resName, "zone_file", regexp.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s\.$`, regexp.QuoteMeta(domainName)))),
+					resource.TestMatchResourceAttr(resName, "zone_file", regexp.MustCompile(`(?m)^www\t\S*\tIN\tA\t192\.0\.2\.1$`)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeDomainZonefileDataSourceConfig(domain string) string {
+	return testAccCheckLinodeDomainRecordConfigBasic(domain) + `
+
+data "linode_domain_zonefile" "foobar" {
+	domain_id = "${linode_domain_record.foobar.domain_id}"
+}`
+}
@@ -0,0 +1,241 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// imageFilterSchema returns the arguments shared by the linode_image and linode_images data sources
+func imageFilterSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"label_regex": &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "A regular expression the label of the Image must match.",
+			Optional:     true,
+			ValidateFunc: validateRegexp,
+		},
+		"is_public": &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Only match public Images when true, or private Images when false.",
+			Optional:    true,
+		},
+		"vendor": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The upstream distribution vendor the Image must have, e.g. Debian.",
+			Optional:    true,
+		},
+		"type": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "How the Image must have been created, manual or automatic.",
+			Optional:    true,
+		},
+		"deprecated": &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Only match deprecated Images when true, or current Images when false.",
+			Optional:    true,
+		},
+	}
+}
+
+// imageAttributeSchema returns the attributes exported for each Image
+func imageAttributeSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"id": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The unique ID of this Image.",
+			Computed:    true,
+		},
+		"label": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "A short description of the Image.",
+			Computed:    true,
+		},
+		"description": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "A detailed description of this Image.",
+			Computed:    true,
+		},
+		"created": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "When this Image was created.",
+			Computed:    true,
+		},
+		"created_by": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The name of the User who created this Image, or linode for public Images.",
+			Computed:    true,
+		},
+		"deprecated": &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "Whether or not this Image is deprecated.",
+			Computed:    true,
+		},
+		"is_public": &schema.Schema{
+			Type:        schema.TypeBool,
+			Description: "True if the Image is public.",
+			Computed:    true,
+		},
+		"size": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The minimum size this Image needs to deploy. Size is in MB.",
+			Computed:    true,
+		},
+		"type": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "How the Image was created, manual or automatic.",
+			Computed:    true,
+		},
+		"vendor": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The upstream distribution vendor. Empty for private Images.",
+			Computed:    true,
+		},
+	}
+}
+
+func dataSourceLinodeImage() *schema.Resource {
+	s := imageFilterSchema()
+	for k, v := range imageAttributeSchema() {
+		if k == "id" {
+			continue
+		}
+		if filter, ok := s[k]; ok {
+			// Filter arguments also report the value of the selected Image
+			filter.Computed = true
+			continue
+		}
+		s[k] = v
+	}
+	s["most_recent"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Use the most recently created Image when more than one Image matches.",
+		Optional:    true,
+		Default:     false,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeImageRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	images, err := listFilteredImages(&client, d)
+	if err != nil {
+		return err
+	}
+
+	if len(images) == 0 {
+		return fmt.Errorf("Your query returned no Linode Images. Please change your search criteria and try again.")
+	}
+	if len(images) > 1 && !d.Get("most_recent").(bool) {
+		return fmt.Errorf("Your query returned %d Linode Images. Please try a more specific search criteria, or set `most_recent` attribute to true.", len(images))
+	}
+
+	image := images[0]
+	d.SetId(image.ID)
+	for k, v := range flattenImage(image) {
+		if k != "id" {
+			d.Set(k, v)
+		}
+	}
+
+	return nil
+}
+
+// listFilteredImages lists the Images matching the filter arguments of the data source, most recently created first
+func listFilteredImages(client *linodego.Client, d *schema.ResourceData) ([]*linodego.Image, error) {
+	images, err := client.ListImages(context.TODO(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list Linode Images because %s", err)
+	}
+
+	filter := imageFilter{
+		vendor:    d.Get("vendor").(string),
+		imageType: d.Get("type").(string),
+	}
+	if labelRegex, ok := d.GetOk("label_regex"); ok {
+		filter.labelRegex = regexp.MustCompile(labelRegex.(string))
+	}
+	if isPublic, ok := d.GetOkExists("is_public"); ok {
+		b := isPublic.(bool)
+		filter.isPublic = &b
+	}
+	if deprecated, ok := d.GetOkExists("deprecated"); ok {
+		b := deprecated.(bool)
+		filter.deprecated = &b
+	}
+
+	return filter.apply(images), nil
+}
+
+// imageFilter matches Images against the optional criteria that are set
+type imageFilter struct {
+	labelRegex *regexp.Regexp
+	isPublic   *bool
+	vendor     string
+	imageType  string
+	deprecated *bool
+}
+
+// apply returns the matching Images, sorted by creation date with the most recent first
+func (f imageFilter) apply(images []*linodego.Image) []*linodego.Image {
+	var result []*linodego.Image
+	for _, image := range images {
+		if f.labelRegex != nil && !f.labelRegex.MatchString(image.Label) {
+			continue
+		}
+		if f.isPublic != nil && image.IsPublic != *f.isPublic {
+			continue
+		}
+		if f.vendor != "" && image.Vendor != f.vendor {
+			continue
+		}
+		if f.imageType != "" && image.Type != f.imageType {
+			continue
+		}
+		if f.deprecated != nil && image.Deprecated != *f.deprecated {
+			continue
+		}
+		result = append(result, image)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		if result[i].Created == nil || result[j].Created == nil {
+			return result[j].Created == nil && result[i].Created != nil
+		}
+		return result[i].Created.After(*result[j].Created)
+	})
+	return result
+}
+
+// flattenImage converts an Image into a map of its attributes
+func flattenImage(image *linodego.Image) map[string]interface{} {
+	return map[string]interface{}{
+		"id":          image.ID,
+		"label":       image.Label,
+		"description": image.Description,
+		"created":     formatInstanceTime(image.Created),
+		"created_by":  image.CreatedBy,
+		"deprecated":  image.Deprecated,
+		"is_public":   image.IsPublic,
+		"size":        image.Size,
+		"type":        image.Type,
+		"vendor":      image.Vendor,
+	}
+}
+
+// validateRegexp ensures the value is a valid regular expression
+func validateRegexp(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid regular expression: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,126 @@
+package linode
+
+import (
+	"encoding/json"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestImageFilterApply(t *testing.T) {
+	t.Parallel()
+
+	older := time.Date(2018, 6, 1, 0, 0, 0, 0, time.UTC)
+	newer := time.Date(2018, 7, 1, 0, 0, 0, 0, time.UTC)
+	images := []*linodego.Image{
+		{ID: "linode/debian8", Label: "Debian 8", IsPublic: true, Vendor: "Debian", Deprecated: true, Created: &older},
+		{ID: "linode/debian9", Label: "Debian 9", IsPublic: true, Vendor: "Debian", Created: &newer},
+		{ID: "private/1", Label: "web-base-1", Type: "manual", Created: &older},
+		{ID: "private/2", Label: "web-base-2", Type: "manual", Created: &newer},
+		{ID: "private/3", Label: "db-base-1", Type: "manual", Created: &newer},
+	}
+
+	isPrivate, notDeprecated := false, false
+	cases := []struct {
+		filter   imageFilter
+		expected []string
+	}{
+		{imageFilter{labelRegex: regexp.MustCompile("^web-base-"), isPublic: &isPrivate}, []string{"private/2", "private/1"}},
+		{imageFilter{vendor: "Debian", deprecated: &notDeprecated}, []string{"linode/debian9"}},
+		{imageFilter{vendor: "Debian"}, []string{"linode/debian9", "linode/debian8"}},
+		{imageFilter{imageType: "automatic"}, nil},
+	}
+
+	for _, tc := range cases {
+		result := tc.filter.apply(images)
+		if len(result) != len(tc.expected) {
+			t.Errorf("expected %v, got %d images", tc.expected, len(result))
+			continue
+		}
+		for i, image := range result {
+			if image.ID != tc.expected[i] {
+				t.Errorf("expected %v, got %s at %d", tc.expected, image.ID, i)
+			}
+		}
+	}
+}
+
+func TestFlattenImageDecoded(t *testing.T) {
+	t.Parallel()
+
+	// An Image as returned by GET /images/linode/debian9
+	payload := `{
+		"id": "linode/debian9",
+		"label": "Debian 9",
+		"description": null,
+		"created": "2017-06-16T20:02:29",
+		"updated": "2017-06-16T20:02:29",
+		"type": "manual",
+		"is_public": true,
+		"size": 1100,
+		"vendor": "Debian",
+		"deprecated": false,
+		"created_by": "linode"
+	}`
+
+	var image linodego.Image
+	if err := json.Unmarshal([]byte(payload), &image); err != nil {
+		t.Fatalf("failed to decode the Image: %s", err)
+	}
+
+	attributes := flattenImage(&image)
+	for k, expected := range map[string]interface{}{
+		"id":         "linode/debian9",
+		"label":      "Debian 9",
+		"is_public":  true,
+		"deprecated": false,
+		"size":       1100,
+		"vendor":     "Debian",
+		"created_by": "linode",
+	} {
+		if attributes[k] != expected {
+			t.Errorf("expected %s to be %v, got %v", k, expected, attributes[k])
+		}
+	}
+}
+
+func TestAccDataSourceLinodeImage(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_image.debian"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeImageDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "vendor", "Debian"),
+					resource.TestCheckResourceAttr(resName, "is_public", "true"),
+					resource.TestCheckResourceAttr(resName, "deprecated", "false"),
+					resource.TestMatchResourceAttr(resName, "id", regexp.MustCompile("^linode/debian")),
+					resource.TestCheckResourceAttrSet("data.linode_images.debian", "images.0.id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeImageDataSourceConfigBasic() string {
+	return `
+data "linode_image" "debian" {
+	vendor = "Debian"
+	is_public = true
+	deprecated = false
+	most_recent = true
+}
+
+data "linode_images" "debian" {
+	vendor = "Debian"
+	is_public = true
+}`
+}
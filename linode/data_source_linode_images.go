@@ -0,0 +1,48 @@
+package linode
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeImages() *schema.Resource {
+	s := imageFilterSchema()
+	s["images"] = &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The matching Images, most recently created first.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: imageAttributeSchema(),
+		},
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeImagesRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeImagesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	images, err := listFilteredImages(&client, d)
+	if err != nil {
+		return err
+	}
+
+	var ids bytes.Buffer
+	result := make([]map[string]interface{}, 0, len(images))
+	for _, image := range images {
+		ids.WriteString(fmt.Sprintf("%s-", image.ID))
+		result = append(result, flattenImage(image))
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(ids.String())))
+	d.Set("images", result)
+
+	return nil
+}
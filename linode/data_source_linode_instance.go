@@ -0,0 +1,267 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// instanceDataSourceSchema returns the attributes exported for each Linode instance by the
+// linode_instance and linode_instances data sources. They match the computed attributes of
+// the linode_instance resource, plus the configs and disks of the instance.
+func instanceDataSourceSchema() map[string]*schema.Schema {
+	computedString := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeString, Description: description, Computed: true}
+	}
+	computedInt := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeInt, Description: description, Computed: true}
+	}
+	computedBool := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeBool, Description: description, Computed: true}
+	}
+	computedStringList := func(description string) *schema.Schema {
+		return &schema.Schema{Type: schema.TypeList, Elem: &schema.Schema{Type: schema.TypeString}, Description: description, Computed: true}
+	}
+
+	device := &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk_id":   computedInt("The ID of the disk in this slot."),
+				"volume_id": computedInt("The ID of the Block Storage volume in this slot."),
+			},
+		},
+	}
+	devices := map[string]*schema.Schema{}
+	for _, slot := range instanceConfigDeviceSlots {
+		devices[slot] = device
+	}
+
+	return map[string]*schema.Schema{
+		"label":              computedString("The label of the Linode instance."),
+		"region":             computedString("The region where the Linode instance is deployed."),
+		"type":               computedString("The type of the Linode instance."),
+		"status":             computedString("The status of the Linode instance."),
+		"image_id":           computedString("The image the Linode instance was last deployed from."),
+		"tags":               computedStringList("The tags applied to the Linode instance."),
+		"hypervisor":         computedString("The virtualization software powering the Linode instance."),
+		"watchdog_enabled":   computedBool("Whether the Lassie shutdown watchdog is enabled."),
+		"created":            computedString("When the Linode instance was created."),
+		"updated":            computedString("When the Linode instance was last updated."),
+		"ip_address":         computedString("The first public IPv4 address of the Linode instance."),
+		"private_ip_address": computedString("The first private IPv4 address of the Linode instance."),
+		"ipv4":               computedStringList("The public, private and shared IPv4 addresses of the Linode instance."),
+		"ipv6":               computedStringList("The SLAAC and link-local IPv6 addresses and the global IPv6 ranges of the Linode instance."),
+		"ip_addresses": &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "The details of every IPv4 and IPv6 address assigned to the Linode instance.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"address":     computedString("The IP address."),
+					"type":        computedString("The type of address, ipv4 or ipv6."),
+					"public":      computedBool("Whether the address is publicly routable."),
+					"prefix":      computedInt("The number of bits of the network prefix."),
+					"gateway":     computedString("The default gateway of the address."),
+					"subnet_mask": computedString("The subnet mask of the address."),
+					"rdns":        computedString("The reverse DNS entry of the address."),
+				},
+			},
+		},
+		"specs": &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "The resources available to the Linode instance, as given by its type.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"disk":     computedInt("The amount of local storage (MB) available to the Linode instance."),
+					"memory":   computedInt("The amount of memory (MB) available to the Linode instance."),
+					"vcpus":    computedInt("The number of virtual CPUs available to the Linode instance."),
+					"transfer": computedInt("The monthly network transfer (GB) included with the Linode instance."),
+				},
+			},
+		},
+		"storage":          computedInt("The total amount of local disk space (MB) available to this Linode instance."),
+		"storage_utilized": computedInt("The total amount of local disk space (MB) utilized by this Linode instance."),
+		"configs": &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "The Linode Configs of the Linode instance.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":             computedInt("The ID of the Linode Config."),
+					"label":          computedString("The label of the Linode Config."),
+					"kernel":         computedString("The kernel used at boot by the Linode Config."),
+					"root_device":    computedString("The device the Linode Config boots from."),
+					"helper_distro":  computedBool("Whether the Distribution Helper is enabled."),
+					"helper_network": computedBool("Whether the Network Helper is enabled."),
+					"devices": &schema.Schema{
+						Type:        schema.TypeList,
+						Description: "The disks and Block Storage volumes mapped into the sda-sdh device slots of the Linode Config.",
+						Computed:    true,
+						Elem:        &schema.Resource{Schema: devices},
+					},
+				},
+			},
+		},
+		"disks": &schema.Schema{
+			Type:        schema.TypeList,
+			Description: "The disks of the Linode instance.",
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"id":         computedInt("The ID of the disk."),
+					"label":      computedString("The label of the disk."),
+					"size":       computedInt("The size of the disk in MB."),
+					"filesystem": computedString("The filesystem of the disk."),
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLinodeInstance() *schema.Resource {
+	s := instanceDataSourceSchema()
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The ID of the Linode instance to look up.",
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"label"},
+	}
+	s["label"].Optional = true
+	s["label"].Description = "The unique label of the Linode instance to look up."
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeInstanceRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	var instance *linodego.Instance
+
+	if id, ok := d.GetOk("id"); ok {
+		var linodeID int
+		if _, err := fmt.Sscanf(id.(string), "%d", &linodeID); err != nil {
+			return fmt.Errorf("Failed to parse Linode instance ID %s as int because %s", id, err)
+		}
+		i, err := client.GetInstance(context.TODO(), linodeID)
+		if err != nil {
+			return fmt.Errorf("Failed to get Linode instance %d because %s", linodeID, err)
+		}
+		instance = i
+	} else if label, ok := d.GetOk("label"); ok {
+		filter, _ := json.Marshal(map[string]interface{}{"label": label})
+		instances, err := client.ListInstances(context.TODO(), linodego.NewListOptions(0, string(filter)))
+		if err != nil {
+			return fmt.Errorf("Failed to list Linode instances because %s", err)
+		}
+		if len(instances) != 1 {
+			return fmt.Errorf("Expected a single Linode instance labeled %s but found %d", label, len(instances))
+		}
+		instance = instances[0]
+	} else {
+		return fmt.Errorf("One of id or label must be set to look up a Linode instance")
+	}
+
+	attributes, err := flattenInstance(&client, instance)
+	if err != nil {
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%d", instance.ID))
+	for k, v := range attributes {
+		d.Set(k, v)
+	}
+
+	return nil
+}
+
+// flattenInstance gathers the attributes of an instance, including its IP addresses, configs and disks
+func flattenInstance(client *linodego.Client, instance *linodego.Instance) (map[string]interface{}, error) {
+	network, err := client.GetInstanceIPAddresses(context.TODO(), instance.ID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the IPs for Linode instance %d because %s", instance.ID, err)
+	}
+	configs, err := client.ListInstanceConfigs(context.TODO(), instance.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the configs for Linode instance %d because %s", instance.ID, err)
+	}
+	disks, err := client.ListInstanceDisks(context.TODO(), instance.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the disks for Linode instance %d because %s", instance.ID, err)
+	}
+
+	ipv4, ipv6, ipAddresses := flattenInstanceIPAddresses(network)
+	result := map[string]interface{}{
+		"label":              instance.Label,
+		"region":             instance.Region,
+		"type":               instance.Type,
+		"status":             string(instance.Status),
+		"image_id":           instance.Image,
+		"tags":               instance.Tags,
+		"hypervisor":         instance.Hypervisor,
+		"watchdog_enabled":   instance.WatchdogEnabled,
+		"created":            formatInstanceTime(instance.Created),
+		"updated":            formatInstanceTime(instance.Updated),
+		"ip_address":         "",
+		"private_ip_address": "",
+		"ipv4":               ipv4,
+		"ipv6":               ipv6,
+		"ip_addresses":       ipAddresses,
+		"specs":              flattenInstanceSpecs(instance.Specs),
+		"storage":            0,
+	}
+
+	if network.IPv4 != nil {
+		if len(network.IPv4.Public) > 0 {
+			result["ip_address"] = network.IPv4.Public[0].Address
+		}
+		if len(network.IPv4.Private) > 0 {
+			result["private_ip_address"] = network.IPv4.Private[0].Address
+		}
+	}
+	if instance.Specs != nil {
+		result["storage"] = instance.Specs.Disk
+	}
+
+	flatConfigs := make([]map[string]interface{}, 0, len(configs))
+	for _, config := range configs {
+		c := map[string]interface{}{
+			"id":          config.ID,
+			"label":       config.Label,
+			"kernel":      config.Kernel,
+			"root_device": config.RootDevice,
+			"devices":     flattenInstanceConfigDevices(config.Devices),
+		}
+		if config.Helpers != nil {
+			c["helper_distro"] = config.Helpers.Distro
+			c["helper_network"] = config.Helpers.Network
+		}
+		flatConfigs = append(flatConfigs, c)
+	}
+	result["configs"] = flatConfigs
+
+	storageUtilized := 0
+	flatDisks := make([]map[string]interface{}, 0, len(disks))
+	for _, disk := range disks {
+		storageUtilized += disk.Size
+		flatDisks = append(flatDisks, map[string]interface{}{
+			"id":         disk.ID,
+			"label":      disk.Label,
+			"size":       disk.Size,
+			"filesystem": disk.Filesystem,
+		})
+	}
+	result["disks"] = flatDisks
+	result["storage_utilized"] = storageUtilized
+
+	return result, nil
+}
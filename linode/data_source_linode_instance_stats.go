@@ -0,0 +1,167 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// instanceStatsSeries are the names of the statistics series exposed by the linode_instance_stats data source.
+// Each series is exported as a list of samples and as an average named "<series>_average".
+var instanceStatsSeries = []string{
+	"cpu",
+	"io",
+	"swap",
+	"netv4_in",
+	"netv4_out",
+	"netv4_private_in",
+	"netv4_private_out",
+	"netv6_in",
+	"netv6_out",
+}
+
+func dataSourceLinodeInstanceStats() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"linode_id": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The ID of the Linode instance to get statistics for.",
+			Required:    true,
+		},
+		"year": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The year of the monthly statistics to get. The last 24 hours are used when not set.",
+			Optional:    true,
+		},
+		"month": &schema.Schema{
+			Type:         schema.TypeInt,
+			Description:  "The month (1-12) of the monthly statistics to get. The last 24 hours are used when not set.",
+			Optional:     true,
+			ValidateFunc: validateStatsMonth,
+		},
+		"title": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The title of the statistics.",
+			Computed:    true,
+		},
+	}
+
+	for _, name := range instanceStatsSeries {
+		s[name] = &schema.Schema{
+			Type:        schema.TypeList,
+			Description: fmt.Sprintf("The %s samples of the Linode instance.", name),
+			Computed:    true,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"time": &schema.Schema{
+						Type:        schema.TypeInt,
+						Description: "The time of the sample in seconds since the Unix epoch.",
+						Computed:    true,
+					},
+					"value": &schema.Schema{
+						Type:        schema.TypeFloat,
+						Description: "The value of the sample.",
+						Computed:    true,
+					},
+				},
+			},
+		}
+		s[name+"_average"] = &schema.Schema{
+			Type:        schema.TypeFloat,
+			Description: fmt.Sprintf("The average of the %s samples of the Linode instance.", name),
+			Computed:    true,
+		}
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeInstanceStatsRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeInstanceStatsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	linodeID := d.Get("linode_id").(int)
+	year, yearOk := d.GetOk("year")
+	month, monthOk := d.GetOk("month")
+
+	var stats *linodego.InstanceStats
+	var err error
+
+	if yearOk != monthOk {
+		return fmt.Errorf("Both year and month must be set to get the monthly statistics of Linode instance %d", linodeID)
+	} else if yearOk {
+		stats, err = client.GetInstanceStatsByDate(context.TODO(), linodeID, year.(int), month.(int))
+		d.SetId(fmt.Sprintf("%d-%d-%02d", linodeID, year.(int), month.(int)))
+	} else {
+		stats, err = client.GetInstanceStats(context.TODO(), linodeID)
+		d.SetId(fmt.Sprintf("%d", linodeID))
+	}
+
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("Failed to get the statistics of Linode instance %d because %s", linodeID, err)
+	}
+
+	series := map[string][]linodego.StatsPoint{
+		"cpu":               stats.Data.CPU,
+		"io":                stats.Data.IO.IO,
+		"swap":              stats.Data.IO.Swap,
+		"netv4_in":          stats.Data.NetV4.In,
+		"netv4_out":         stats.Data.NetV4.Out,
+		"netv4_private_in":  stats.Data.NetV4.PrivateIn,
+		"netv4_private_out": stats.Data.NetV4.PrivateOut,
+		"netv6_in":          stats.Data.NetV6.In,
+		"netv6_out":         stats.Data.NetV6.Out,
+	}
+
+	d.Set("title", stats.Title)
+	for _, name := range instanceStatsSeries {
+		d.Set(name, flattenStatsSeries(series[name]))
+		d.Set(name+"_average", statsAverage(series[name]))
+	}
+
+	return nil
+}
+
+// flattenStatsSeries converts [timestamp, value] samples into a list of maps. The API returns the
+// timestamps in milliseconds, which are kept in seconds so they fit an int on 32-bit platforms.
+func flattenStatsSeries(points []linodego.StatsPoint) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(points))
+	for _, point := range points {
+		if len(point) != 2 {
+			continue
+		}
+		result = append(result, map[string]interface{}{
+			"time":  int(point[0] / 1000),
+			"value": point[1],
+		})
+	}
+	return result
+}
+
+// statsAverage returns the average value of the samples, or 0 when there are none
+func statsAverage(points []linodego.StatsPoint) float64 {
+	sum, count := 0.0, 0
+	for _, point := range points {
+		if len(point) != 2 {
+			continue
+		}
+		sum += point[1]
+		count++
+	}
+	if count == 0 {
+		return 0
+	}
+	return sum / float64(count)
+}
+
+// validateStatsMonth ensures month is a calendar month
+func validateStatsMonth(v interface{}, k string) (ws []string, errors []error) {
+	if month := v.(int); month < 1 || month > 12 {
+		errors = append(errors, fmt.Errorf("%q must be between 1 and 12, got %d", k, month))
+	}
+	return
+}
@@ -0,0 +1,64 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestStatsAverage(t *testing.T) {
+	t.Parallel()
+
+	points := []linodego.StatsPoint{{1531440000000, 1.5}, {1531440300000, 2.5}, {1531440600000}}
+	if avg := statsAverage(points); avg != 2 {
+		t.Errorf("expected an average of 2, got %f", avg)
+	}
+	if avg := statsAverage(nil); avg != 0 {
+		t.Errorf("expected an average of 0 without samples, got %f", avg)
+	}
+	if series := flattenStatsSeries(points); len(series) != 2 || series[1]["time"] != 1531440300 {
+		t.Errorf("unexpected series %v", series)
+	}
+}
+
+func TestAccDataSourceLinodeInstanceStats(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_instance_stats.foobar"
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceStatsConfigBasic(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resName, "title"),
+					resource.TestCheckResourceAttrSet(resName, "cpu_average"),
+					resource.TestCheckResourceAttrSet(resName, "netv4_out_average"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceStatsConfigBasic(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_password = "terraform-test"
+	swap_size = 256
+}
+
+data "linode_instance_stats" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+}`, instance)
+}
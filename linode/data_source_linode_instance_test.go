@@ -0,0 +1,59 @@
+package linode
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeInstance(t *testing.T) {
+	t.Parallel()
+
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceDataSourceConfigBasic(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.linode_instance.by_id", "label", "linode_instance.foobar", "label"),
+					resource.TestCheckResourceAttrPair("data.linode_instance.by_id", "ip_address", "linode_instance.foobar", "ip_address"),
+					resource.TestCheckResourceAttrPair("data.linode_instance.by_label", "id", "linode_instance.foobar", "id"),
+					resource.TestCheckResourceAttr("data.linode_instance.by_label", "specs.0.memory", "1024"),
+					resource.TestCheckResourceAttr("data.linode_instance.by_label", "configs.#", "1"),
+					resource.TestCheckResourceAttr("data.linode_instance.by_label", "disks.#", "2"),
+					resource.TestCheckResourceAttr("data.linode_instances.nanodes", "instances.#", "1"),
+					resource.TestCheckResourceAttrPair("data.linode_instances.nanodes", "instances.0.id", "linode_instance.foobar", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceDataSourceConfigBasic(instance string, pubkey string) string {
+	return testAccCheckLinodeInstanceConfigBasic(instance, pubkey) + fmt.Sprintf(`
+
+data "linode_instance" "by_id" {
+	id = "${linode_instance.foobar.id}"
+}
+
+data "linode_instance" "by_label" {
+	label = "${linode_instance.foobar.label}"
+}
+
+data "linode_instances" "nanodes" {
+	label_regex = "^%s$"
+	type = "g6-nanode-1"
+	region = "us-east"
+	depends_on = ["linode_instance.foobar"]
+}`, instance)
+}
@@ -0,0 +1,209 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// instanceTypeConstraintArgs are the arguments used to select the cheapest matching type
+var instanceTypeConstraintArgs = []string{"min_memory", "min_vcpus", "min_disk", "min_transfer", "class"}
+
+func instanceTypePriceSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: description,
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"hourly": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Description: "Cost (in US dollars) per hour.",
+					Computed:    true,
+				},
+				"monthly": &schema.Schema{
+					Type:        schema.TypeFloat,
+					Description: "Cost (in US dollars) per month.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLinodeInstanceType() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeInstanceTypeRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:          schema.TypeString,
+				Description:   "The ID of the Linode type to look up, e.g. g6-standard-1.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: instanceTypeConstraintArgs,
+			},
+			"min_memory": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The minimum amount of memory (MB) of the type.",
+				Optional:    true,
+			},
+			"min_vcpus": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The minimum number of virtual CPUs of the type.",
+				Optional:    true,
+			},
+			"min_disk": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The minimum amount of local storage (MB) of the type.",
+				Optional:    true,
+			},
+			"min_transfer": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The minimum monthly network transfer (GB) of the type.",
+				Optional:    true,
+			},
+			"class": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The class of the type. (nanode, standard, highmem)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The label of the type.",
+				Computed:    true,
+			},
+			"memory": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The amount of memory (MB) of the type.",
+				Computed:    true,
+			},
+			"vcpus": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The number of virtual CPUs of the type.",
+				Computed:    true,
+			},
+			"disk": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The amount of local storage (MB) of the type.",
+				Computed:    true,
+			},
+			"transfer": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The monthly network transfer (GB) included with the type.",
+				Computed:    true,
+			},
+			"network_out": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The outbound bandwidth cap (Mbits) of the type.",
+				Computed:    true,
+			},
+			"price":         instanceTypePriceSchema("The cost of the type."),
+			"backups_price": instanceTypePriceSchema("The cost of the Backup service for the type."),
+		},
+	}
+}
+
+func dataSourceLinodeInstanceTypeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	var linodeType *linodego.LinodeType
+
+	if id, ok := d.GetOk("id"); ok {
+		t, err := client.GetType(context.TODO(), id.(string))
+		if err != nil {
+			return fmt.Errorf("Failed to get Linode type %s because %s", id, err)
+		}
+		linodeType = t
+	} else {
+		types, err := client.ListTypes(context.TODO(), nil)
+		if err != nil {
+			return fmt.Errorf("Failed to list Linode types because %s", err)
+		}
+
+		constraints := instanceTypeConstraints{
+			minMemory:   d.Get("min_memory").(int),
+			minVCPUs:    d.Get("min_vcpus").(int),
+			minDisk:     d.Get("min_disk").(int),
+			minTransfer: d.Get("min_transfer").(int),
+			class:       d.Get("class").(string),
+		}
+		if linodeType = constraints.cheapest(types); linodeType == nil {
+			return fmt.Errorf("No Linode type satisfies the given constraints")
+		}
+	}
+
+	d.SetId(linodeType.ID)
+	d.Set("class", linodeType.Class)
+	d.Set("label", linodeType.Label)
+	d.Set("memory", linodeType.Memory)
+	d.Set("vcpus", linodeType.VCPUs)
+	d.Set("disk", linodeType.Disk)
+	d.Set("transfer", linodeType.Transfer)
+	d.Set("network_out", linodeType.NetworkOut)
+	d.Set("price", flattenLinodePrice(linodeType.Price))
+	if linodeType.Addons != nil && linodeType.Addons.Backups != nil {
+		d.Set("backups_price", flattenLinodePrice(linodeType.Addons.Backups.Price))
+	}
+
+	return nil
+}
+
+// instanceTypeConstraints are the requirements a Linode type must satisfy. Zero values are not checked.
+type instanceTypeConstraints struct {
+	minMemory   int
+	minVCPUs    int
+	minDisk     int
+	minTransfer int
+	class       string
+}
+
+func (c instanceTypeConstraints) satisfiedBy(t *linodego.LinodeType) bool {
+	return t.Memory >= c.minMemory &&
+		t.VCPUs >= c.minVCPUs &&
+		t.Disk >= c.minDisk &&
+		t.Transfer >= c.minTransfer &&
+		(c.class == "" || t.Class == c.class)
+}
+
+// cheapest returns the type with the lowest monthly price satisfying the constraints, or nil
+func (c instanceTypeConstraints) cheapest(types []*linodego.LinodeType) *linodego.LinodeType {
+	var candidates []*linodego.LinodeType
+	for _, t := range types {
+		if t.Price != nil && c.satisfiedBy(t) {
+			candidates = append(candidates, t)
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].Price.Monthly != candidates[j].Price.Monthly {
+			return candidates[i].Price.Monthly < candidates[j].Price.Monthly
+		}
+		return candidates[i].ID < candidates[j].ID
+	})
+	return candidates[0]
+}
+
+// flattenLinodePrice converts a price into the single element list stored in price attributes
+func flattenLinodePrice(price *linodego.LinodePrice) []map[string]interface{} {
+	if price == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"hourly":  float32ToFloat64(price.Hourly),
+		"monthly": float32ToFloat64(price.Monthly),
+	}}
+}
+
+// float32ToFloat64 converts prices without the float32 rounding noise, so 0.0075 stays 0.0075
+func float32ToFloat64(f float32) float64 {
+	result, _ := strconv.ParseFloat(strconv.FormatFloat(float64(f), 'f', -1, 32), 64)
+	return result
+}
@@ -0,0 +1,86 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestInstanceTypeConstraintsCheapest(t *testing.T) {
+	t.Parallel()
+
+	types := []*linodego.LinodeType{
+		{ID: "g6-standard-2", Class: "standard", Memory: 4096, VCPUs: 2, Disk: 81920, Transfer: 3000, Price: &linodego.LinodePrice{Monthly: 20}},
+		{ID: "g6-nanode-1", Class: "nanode", Memory: 1024, VCPUs: 1, Disk: 25600, Transfer: 1000, Price: &linodego.LinodePrice{Monthly: 5}},
+		{ID: "g6-standard-1", Class: "standard", Memory: 2048, VCPUs: 1, Disk: 51200, Transfer: 2000, Price: &linodego.LinodePrice{Monthly: 10}},
+		{ID: "g6-highmem-1", Class: "highmem", Memory: 16384, VCPUs: 1, Disk: 20480, Transfer: 5000, Price: &linodego.LinodePrice{Monthly: 60}},
+	}
+
+	cases := []struct {
+		constraints instanceTypeConstraints
+		expected    string
+	}{
+		{instanceTypeConstraints{}, "g6-nanode-1"},
+		{instanceTypeConstraints{minMemory: 2048}, "g6-standard-1"},
+		{instanceTypeConstraints{minVCPUs: 2}, "g6-standard-2"},
+		{instanceTypeConstraints{minTransfer: 4000}, "g6-highmem-1"},
+		{instanceTypeConstraints{class: "standard", minDisk: 60000}, "g6-standard-2"},
+		{instanceTypeConstraints{minMemory: 32768}, ""},
+	}
+
+	for _, tc := range cases {
+		result := tc.constraints.cheapest(types)
+		if tc.expected == "" {
+			if result != nil {
+				t.Errorf("expected no type for %+v, got %s", tc.constraints, result.ID)
+			}
+			continue
+		}
+		if result == nil || result.ID != tc.expected {
+			t.Errorf("expected %s for %+v, got %v", tc.expected, tc.constraints, result)
+		}
+	}
+}
+
+func TestFlattenLinodePrice(t *testing.T) {
+	t.Parallel()
+
+	price := flattenLinodePrice(&linodego.LinodePrice{Hourly: 0.0075, Monthly: 5})
+	if price[0]["hourly"] != 0.0075 || price[0]["monthly"] != 5.0 {
+		t.Errorf("unexpected price %v", price)
+	}
+}
+
+func TestAccDataSourceLinodeInstanceType(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceTypeDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linode_instance_type.nanode", "class", "nanode"),
+					resource.TestCheckResourceAttr("data.linode_instance_type.nanode", "memory", "1024"),
+					resource.TestCheckResourceAttrSet("data.linode_instance_type.nanode", "price.0.monthly"),
+					resource.TestCheckResourceAttrSet("data.linode_instance_type.nanode", "backups_price.0.monthly"),
+					resource.TestCheckResourceAttr("data.linode_instance_type.small", "id", "g6-standard-1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeInstanceTypeDataSourceConfigBasic() string {
+	return `
+data "linode_instance_type" "nanode" {
+	id = "g6-nanode-1"
+}
+
+data "linode_instance_type" "small" {
+	min_memory = 2048
+	class = "standard"
+}`
+}
@@ -0,0 +1,192 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeInstances() *schema.Resource {
+	instanceSchema := instanceDataSourceSchema()
+	instanceSchema["id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "The ID of the Linode instance.",
+		Computed:    true,
+	}
+
+	return &schema.Resource{
+		Read: dataSourceLinodeInstancesRead,
+		Schema: map[string]*schema.Schema{
+			"label_regex": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "A regular expression the label of the Linode instance must match.",
+				Optional:     true,
+				ValidateFunc: validateRegexp,
+			},
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region the Linode instance must be deployed in.",
+				Optional:    true,
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The type the Linode instance must have.",
+				Optional:    true,
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The status the Linode instance must have, e.g. running or offline.",
+				Optional:    true,
+			},
+			"tags": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Tags that must all be applied to the Linode instance.",
+				Optional:    true,
+			},
+			"image": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The image the Linode instance must have been deployed from.",
+				Optional:    true,
+			},
+			"instances": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The matching Linode instances.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: instanceSchema,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceLinodeInstancesRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	filter := instanceFilter{
+		region: d.Get("region").(string),
+		typeID: d.Get("type").(string),
+		status: d.Get("status").(string),
+		image:  d.Get("image").(string),
+		tags:   expandStringList(d.Get("tags").([]interface{})),
+	}
+	if labelRegex, ok := d.GetOk("label_regex"); ok {
+		filter.labelRegex = regexp.MustCompile(labelRegex.(string))
+	}
+
+	filterJSON, err := filter.xFilter()
+	if err != nil {
+		return fmt.Errorf("Failed to build the Linode instance filter because %s", err)
+	}
+	instances, err := client.ListInstances(context.TODO(), linodego.NewListOptions(0, filterJSON))
+	if err != nil {
+		return fmt.Errorf("Failed to list Linode instances because %s", err)
+	}
+
+	var ids bytes.Buffer
+	result := []map[string]interface{}{}
+	for _, instance := range instances {
+		if !filter.matches(instance) {
+			continue
+		}
+		attributes, err := flattenInstance(&client, instance)
+		if err != nil {
+			return err
+		}
+		attributes["id"] = fmt.Sprintf("%d", instance.ID)
+		ids.WriteString(fmt.Sprintf("%d-", instance.ID))
+		result = append(result, attributes)
+	}
+
+	d.SetId(fmt.Sprintf("%d", hashcode.String(ids.String())))
+	d.Set("instances", result)
+
+	return nil
+}
+
+// instanceFilter matches Linode instances against the optional criteria that are set
+type instanceFilter struct {
+	labelRegex *regexp.Regexp
+	region     string
+	typeID     string
+	status     string
+	image      string
+	tags       []string
+}
+
+// xFilter builds the X-Filter JSON that lets the API filter on everything but the label regex.
+// It returns an empty string when there is nothing to filter on.
+func (f instanceFilter) xFilter() (string, error) {
+	var conditions []map[string]interface{}
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"region", f.region},
+		{"type", f.typeID},
+		{"status", f.status},
+		{"image", f.image},
+	} {
+		if field.value != "" {
+			conditions = append(conditions, map[string]interface{}{field.name: field.value})
+		}
+	}
+	for _, tag := range f.tags {
+		conditions = append(conditions, map[string]interface{}{"tags": tag})
+	}
+
+	var filter interface{}
+	switch len(conditions) {
+	case 0:
+		return "", nil
+	case 1:
+		filter = conditions[0]
+	default:
+		filter = map[string]interface{}{"+and": conditions}
+	}
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// matches checks an instance returned by the API against all of the criteria, including the label regex
+func (f instanceFilter) matches(instance *linodego.Instance) bool {
+	if f.labelRegex != nil && !f.labelRegex.MatchString(instance.Label) {
+		return false
+	}
+	if f.region != "" && instance.Region != f.region {
+		return false
+	}
+	if f.typeID != "" && instance.Type != f.typeID {
+		return false
+	}
+	if f.status != "" && string(instance.Status) != f.status {
+		return false
+	}
+	if f.image != "" && instance.Image != f.image {
+		return false
+	}
+	for _, tag := range f.tags {
+		found := false
+		for _, t := range instance.Tags {
+			if t == tag {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
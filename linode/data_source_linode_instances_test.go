@@ -0,0 +1,65 @@
+package linode
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/chiefy/linodego"
+)
+
+func TestInstanceFilterXFilter(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		filter   instanceFilter
+		expected string
+	}{
+		{instanceFilter{}, ""},
+		{instanceFilter{labelRegex: regexp.MustCompile("^web-")}, ""},
+		{instanceFilter{region: "us-east"}, `{"region":"us-east"}`},
+		{instanceFilter{region: "us-east", status: "running", tags: []string{"web", "prod"}},
+			`{"+and":[{"region":"us-east"},{"status":"running"},{"tags":"web"},{"tags":"prod"}]}`},
+	}
+
+	for _, tc := range cases {
+		filter, err := tc.filter.xFilter()
+		if err != nil {
+			t.Errorf("unexpected error %s", err)
+			continue
+		}
+		if filter != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, filter)
+		}
+	}
+}
+
+func TestInstanceFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	instance := &linodego.Instance{
+		Label:  "web-1",
+		Region: "us-east",
+		Type:   "g6-nanode-1",
+		Status: linodego.InstanceRunning,
+		Image:  "linode/debian9",
+		Tags:   []string{"web", "prod"},
+	}
+
+	cases := []struct {
+		filter   instanceFilter
+		expected bool
+	}{
+		{instanceFilter{}, true},
+		{instanceFilter{labelRegex: regexp.MustCompile("^web-"), tags: []string{"prod"}}, true},
+		{instanceFilter{labelRegex: regexp.MustCompile("^db-")}, false},
+		{instanceFilter{region: "us-east", typeID: "g6-nanode-1", status: "running", image: "linode/debian9"}, true},
+		{instanceFilter{status: "offline"}, false},
+		{instanceFilter{tags: []string{"web", "staging"}}, false},
+	}
+
+	for i, tc := range cases {
+		if matches := tc.filter.matches(instance); matches != tc.expected {
+			t.Errorf("expected %t for case %d, got %t", tc.expected, i, matches)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// kernelAliasPrefixes are the IDs of kernels that are not a specific kernel version, such as the
+// latest-64bit alias and the bootloaders. These are never selected by version.
+var kernelAliasPrefixes = []string{"linode/latest", "linode/grub", "linode/direct-disk", "linode/pv-grub"}
+
+func dataSourceLinodeKernel() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeKernelRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:          schema.TypeString,
+				Description:   "The ID of the kernel to look up, e.g. linode/4.17.14-x86_64-linode108.",
+				Optional:      true,
+				Computed:      true,
+				ConflictsWith: []string{"version_constraint"},
+			},
+			"version_constraint": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "A version constraint, e.g. \"~> 4.17\". The latest kernel matching it is selected.",
+				Optional:     true,
+				ValidateFunc: validateVersionConstraint,
+			},
+			"architecture": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The architecture of the kernel. (x86_64, i386)",
+				Optional:    true,
+				Computed:    true,
+			},
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The label of the kernel.",
+				Computed:    true,
+			},
+			"version": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The version of the kernel.",
+				Computed:    true,
+			},
+			"kvm": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the kernel is compatible with KVM.",
+				Computed:    true,
+			},
+			"xen": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the kernel is compatible with Xen.",
+				Computed:    true,
+			},
+			"pvops": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether the kernel supports paravirtualization.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeKernelRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	var kernel *linodego.LinodeKernel
+
+	if id, ok := d.GetOk("id"); ok {
+		k, err := client.GetKernel(context.TODO(), id.(string))
+		if err != nil {
+			return fmt.Errorf("Failed to get Linode kernel %s because %s", id, err)
+		}
+		kernel = k
+	} else {
+		kernels, err := client.ListKernels(context.TODO(), nil)
+		if err != nil {
+			return fmt.Errorf("Failed to list Linode kernels because %s", err)
+		}
+
+		constraints := version.Constraints{}
+		if v, ok := d.GetOk("version_constraint"); ok {
+			constraints, _ = version.NewConstraint(v.(string))
+		}
+		architecture := d.Get("architecture").(string)
+
+		if kernel = latestKernel(kernels, constraints, architecture); kernel == nil {
+			return fmt.Errorf("No Linode kernel matches version %q and architecture %q", d.Get("version_constraint"), architecture)
+		}
+	}
+
+	d.SetId(kernel.ID)
+	d.Set("label", kernel.Label)
+	d.Set("version", kernel.Version)
+	d.Set("architecture", kernel.Architecture)
+	d.Set("kvm", kernel.KVM)
+	d.Set("xen", kernel.XEN)
+	d.Set("pvops", kernel.PVOPS)
+
+	return nil
+}
+
+// latestKernel returns the kernel with the highest version matching the constraints and architecture, or nil
+func latestKernel(kernels []*linodego.LinodeKernel, constraints version.Constraints, architecture string) *linodego.LinodeKernel {
+	var latest *linodego.LinodeKernel
+	var latestVersion *version.Version
+
+	for _, kernel := range kernels {
+		if isKernelAlias(kernel.ID) {
+			continue
+		}
+		if architecture != "" && kernel.Architecture != architecture {
+			continue
+		}
+		v, err := version.NewVersion(kernel.Version)
+		if err != nil || !constraints.Check(v) {
+			continue
+		}
+		if latestVersion == nil || v.GreaterThan(latestVersion) || (v.Equal(latestVersion) && kernel.ID > latest.ID) {
+			latest, latestVersion = kernel, v
+		}
+	}
+	return latest
+}
+
+func isKernelAlias(id string) bool {
+	for _, prefix := range kernelAliasPrefixes {
+		if strings.HasPrefix(id, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// validateVersionConstraint ensures the value can be parsed as a version constraint
+func validateVersionConstraint(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := version.NewConstraint(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q must be a valid version constraint: %s", k, err))
+	}
+	return
+}
@@ -0,0 +1,83 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/chiefy/linodego"
+	version "github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestLatestKernel(t *testing.T) {
+	t.Parallel()
+
+	kernels := []*linodego.LinodeKernel{
+		{ID: "linode/latest-64bit", Version: "4.17.14", Architecture: "x86_64"},
+		{ID: "linode/grub2", Version: "2.02", Architecture: "x86_64"},
+		{ID: "linode/4.16.11-x86_64-linode106", Version: "4.16.11", Architecture: "x86_64"},
+		{ID: "linode/4.17.14-x86_64-linode108", Version: "4.17.14", Architecture: "x86_64"},
+		{ID: "linode/4.17.12-x86_64-linode107", Version: "4.17.12", Architecture: "x86_64"},
+		{ID: "linode/4.17.14-x86-linode108", Version: "4.17.14", Architecture: "i386"},
+	}
+
+	cases := []struct {
+		constraint   string
+		architecture string
+		expected     string
+	}{
+		{"", "x86_64", "linode/4.17.14-x86_64-linode108"},
+		{"~> 4.16.0", "x86_64", "linode/4.16.11-x86_64-linode106"},
+		{">= 4.17, < 4.17.14", "", "linode/4.17.12-x86_64-linode107"},
+		{"", "i386", "linode/4.17.14-x86-linode108"},
+		{"~> 2.0", "", ""},
+	}
+
+	for _, tc := range cases {
+		constraints := version.Constraints{}
+		if tc.constraint != "" {
+			constraints, _ = version.NewConstraint(tc.constraint)
+		}
+		kernel := latestKernel(kernels, constraints, tc.architecture)
+		if tc.expected == "" {
+			if kernel != nil {
+				t.Errorf("expected no kernel for %q, got %s", tc.constraint, kernel.ID)
+			}
+			continue
+		}
+		if kernel == nil || kernel.ID != tc.expected {
+			t.Errorf("expected %s for %q %q, got %v", tc.expected, tc.constraint, tc.architecture, kernel)
+		}
+	}
+}
+
+func TestAccDataSourceLinodeKernel(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeKernelDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.linode_kernel.latest", "architecture", "x86_64"),
+					resource.TestCheckResourceAttr("data.linode_kernel.latest", "kvm", "true"),
+					resource.TestCheckResourceAttrSet("data.linode_kernel.latest", "version"),
+					resource.TestCheckResourceAttr("data.linode_kernel.grub2", "label", "GRUB 2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeKernelDataSourceConfigBasic() string {
+	return `
+data "linode_kernel" "latest" {
+	version_constraint = ">= 4.0"
+	architecture = "x86_64"
+}
+
+data "linode_kernel" "grub2" {
+	id = "linode/grub2"
+}`
+}
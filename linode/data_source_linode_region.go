@@ -0,0 +1,49 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeRegion() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceLinodeRegionRead,
+		Schema: map[string]*schema.Schema{
+			"id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The ID of the region, e.g. us-east.",
+				Required:    true,
+			},
+			"country": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The country the region resides in.",
+				Computed:    true,
+			},
+			"capabilities": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The services available in the region, e.g. Linodes, NodeBalancers and Block Storage.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceLinodeRegionRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	id := d.Get("id").(string)
+	region, err := client.GetRegion(context.TODO(), id)
+	if err != nil {
+		return fmt.Errorf("Failed to get Linode region %s because %s", id, err)
+	}
+
+	d.SetId(region.ID)
+	d.Set("country", region.Country)
+	d.Set("capabilities", region.Capabilities)
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccDataSourceLinodeRegion(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_region.foobar"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeRegionDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "id", "us-east"),
+					resource.TestCheckResourceAttr(resName, "country", "us"),
+					resource.TestCheckResourceAttrSet(resName, "capabilities.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeRegionDataSourceConfigBasic() string {
+	return `
+data "linode_region" "foobar" {
+	id = "us-east"
+}`
+}
@@ -0,0 +1,174 @@
+package linode
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func dataSourceLinodeStackscript() *schema.Resource {
+	// The attributes of the StackScript mirror the linode_stackscript resource
+	s := map[string]*schema.Schema{}
+	for k, v := range resourceLinodeStackscript().Schema {
+		attribute := *v
+		attribute.Required = false
+		attribute.Optional = false
+		attribute.Computed = true
+		attribute.Default = nil
+		attribute.ValidateFunc = nil
+		s[k] = &attribute
+	}
+
+	s["id"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "The ID of the StackScript to look up.",
+		Optional:      true,
+		Computed:      true,
+		ConflictsWith: []string{"label", "username", "is_public", "mine", "image"},
+	}
+	s["label"].Optional = true
+	s["label"].Description = "The label the StackScript must have."
+	s["username"].Optional = true
+	s["username"].Description = "The User who created the StackScript, e.g. linode for the StackScripts of Linode."
+	s["is_public"].Optional = true
+	s["is_public"].Description = "Whether the StackScript must be public or private."
+	s["mine"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Description: "Only look up the StackScripts of the account.",
+		Optional:    true,
+	}
+	s["image"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "An Image ID, e.g. linode/debian9, the StackScript must support.",
+		Optional:    true,
+	}
+
+	return &schema.Resource{
+		Read:   dataSourceLinodeStackscriptRead,
+		Schema: s,
+	}
+}
+
+func dataSourceLinodeStackscriptRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	var stackscript *linodego.Stackscript
+
+	if id, ok := d.GetOk("id"); ok {
+		var stackscriptID int
+		if _, err := fmt.Sscanf(id.(string), "%d", &stackscriptID); err != nil {
+			return fmt.Errorf("Failed to parse Linode StackScript ID %s as int because %s", id, err)
+		}
+		s, err := client.GetStackscript(context.TODO(), stackscriptID)
+		if err != nil {
+			return fmt.Errorf("Failed to get Linode StackScript %d because %s", stackscriptID, err)
+		}
+		stackscript = s
+	} else {
+		filter := stackscriptFilter{
+			label:    d.Get("label").(string),
+			username: d.Get("username").(string),
+			mine:     d.Get("mine").(bool),
+			image:    d.Get("image").(string),
+		}
+		if isPublic, ok := d.GetOkExists("is_public"); ok {
+			public := isPublic.(bool)
+			filter.isPublic = &public
+		}
+		if filter.label == "" && filter.username == "" && !filter.mine {
+			return fmt.Errorf("One of id, label, username or mine must be set to look up a Linode StackScript")
+		}
+
+		filterJSON, err := filter.xFilter()
+		if err != nil {
+			return fmt.Errorf("Failed to build the Linode StackScript filter because %s", err)
+		}
+		stackscripts, err := client.ListStackscripts(context.TODO(), linodego.NewListOptions(0, filterJSON))
+		if err != nil {
+			return fmt.Errorf("Failed to list Linode StackScripts because %s", err)
+		}
+
+		var matches []*linodego.Stackscript
+		for _, s := range stackscripts {
+			if filter.matches(s) {
+				matches = append(matches, s)
+			}
+		}
+		if len(matches) != 1 {
+			return fmt.Errorf("Expected a single Linode StackScript matching %s but found %d", filterJSON, len(matches))
+		}
+		stackscript = matches[0]
+	}
+
+	d.SetId(fmt.Sprintf("%d", stackscript.ID))
+	syncStackscriptResourceData(d, stackscript)
+
+	return nil
+}
+
+// stackscriptFilter matches Linode StackScripts against the optional criteria that are set
+type stackscriptFilter struct {
+	label    string
+	username string
+	isPublic *bool
+	mine     bool
+	image    string
+}
+
+// xFilter builds the X-Filter JSON that lets the API filter on everything but the supported image
+func (f stackscriptFilter) xFilter() (string, error) {
+	var conditions []map[string]interface{}
+	if f.label != "" {
+		conditions = append(conditions, map[string]interface{}{"label": f.label})
+	}
+	if f.username != "" {
+		conditions = append(conditions, map[string]interface{}{"username": f.username})
+	}
+	if f.isPublic != nil {
+		conditions = append(conditions, map[string]interface{}{"is_public": *f.isPublic})
+	}
+	if f.mine {
+		conditions = append(conditions, map[string]interface{}{"mine": true})
+	}
+
+	var filter interface{}
+	switch len(conditions) {
+	case 0:
+		return "", nil
+	case 1:
+		filter = conditions[0]
+	default:
+		filter = map[string]interface{}{"+and": conditions}
+	}
+
+	b, err := json.Marshal(filter)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+// matches checks a StackScript returned by the API against all of the criteria, including the supported image
+func (f stackscriptFilter) matches(stackscript *linodego.Stackscript) bool {
+	if f.label != "" && stackscript.Label != f.label {
+		return false
+	}
+	if f.username != "" && stackscript.Username != f.username {
+		return false
+	}
+	if f.isPublic != nil && stackscript.IsPublic != *f.isPublic {
+		return false
+	}
+	if f.image == "" {
+		return true
+	}
+	for _, image := range stackscript.Images {
+		if image == f.image {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,103 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestStackscriptFilterXFilter(t *testing.T) {
+	t.Parallel()
+
+	public := true
+	cases := []struct {
+		filter   stackscriptFilter
+		expected string
+	}{
+		{stackscriptFilter{}, ""},
+		{stackscriptFilter{image: "linode/debian9"}, ""},
+		{stackscriptFilter{label: "lamp"}, `{"label":"lamp"}`},
+		{stackscriptFilter{label: "lamp", username: "linode", isPublic: &public, mine: true},
+			`{"+and":[{"label":"lamp"},{"username":"linode"},{"is_public":true},{"mine":true}]}`},
+	}
+
+	for _, tc := range cases {
+		filter, err := tc.filter.xFilter()
+		if err != nil {
+			t.Errorf("unexpected error %s", err)
+			continue
+		}
+		if filter != tc.expected {
+			t.Errorf("expected %s, got %s", tc.expected, filter)
+		}
+	}
+}
+
+func TestStackscriptFilterMatches(t *testing.T) {
+	t.Parallel()
+
+	stackscript := &linodego.Stackscript{
+		Label:    "lamp",
+		Username: "linode",
+		IsPublic: true,
+		Images:   []string{"linode/debian9", "linode/ubuntu18.04"},
+	}
+
+	public, private := true, false
+	cases := []struct {
+		filter   stackscriptFilter
+		expected bool
+	}{
+		{stackscriptFilter{}, true},
+		{stackscriptFilter{label: "lamp", username: "linode", isPublic: &public}, true},
+		{stackscriptFilter{label: "lamp-2"}, false},
+		{stackscriptFilter{username: "someone"}, false},
+		{stackscriptFilter{isPublic: &private}, false},
+		{stackscriptFilter{image: "linode/ubuntu18.04"}, true},
+		{stackscriptFilter{image: "linode/centos7"}, false},
+	}
+
+	for _, tc := range cases {
+		if matches := tc.filter.matches(stackscript); matches != tc.expected {
+			t.Errorf("expected %+v to match %t, got %t", tc.filter, tc.expected, matches)
+		}
+	}
+}
+
+func TestAccDataSourceLinodeStackscript(t *testing.T) {
+	t.Parallel()
+
+	resName := "data.linode_stackscript.foobar"
+	var stackscriptName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeStackscriptDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeStackscriptDataSourceConfig(stackscriptName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resName, "id", "linode_stackscript.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "label", stackscriptName),
+					resource.TestCheckResourceAttrPair(resName, "script", "linode_stackscript.foobar", "script"),
+					resource.TestCheckResourceAttrPair(resName, "rev_note", "linode_stackscript.foobar", "rev_note"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.#", "1"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.0.name", "hostname"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeStackscriptDataSourceConfig(label string) string {
+	return testAccCheckLinodeStackscriptConfigBasic(label) + `
+
+data "linode_stackscript" "foobar" {
+	label = "${linode_stackscript.foobar.label}"
+	mine = true
+	image = "linode/debian9"
+}`
+}
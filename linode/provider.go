@@ -25,15 +25,35 @@ func Provider() terraform.ResourceProvider {
 		},
 
 		DataSourcesMap: map[string]*schema.Resource{
-			"linode_ipv6_pool":  dataSourceLinodeComputeIPv6Pool(),
-			"linode_ipv6_range": dataSourceLinodeComputeIPv6Range(),
+			"linode_domain":          dataSourceLinodeDomain(),
+			"linode_domain_record":   dataSourceLinodeDomainRecord(),
+			"linode_domain_zonefile": dataSourceLinodeDomainZonefile(),
+			"linode_image":           dataSourceLinodeImage(),
+			"linode_images":          dataSourceLinodeImages(),
+			"linode_instance":        dataSourceLinodeInstance(),
+			"linode_instance_stats":  dataSourceLinodeInstanceStats(),
+			"linode_instance_type":   dataSourceLinodeInstanceType(),
+			"linode_instances":       dataSourceLinodeInstances(),
+			"linode_ipv6_pool":       dataSourceLinodeComputeIPv6Pool(),
+			"linode_ipv6_range":      dataSourceLinodeComputeIPv6Range(),
+			"linode_kernel":          dataSourceLinodeKernel(),
+			"linode_region":          dataSourceLinodeRegion(),
+			"linode_stackscript":     dataSourceLinodeStackscript(),
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"linode_domain":              resourceLinodeDomain(),
+			"linode_domain_record":       resourceLinodeDomainRecord(),
+			"linode_domain_records":      resourceLinodeDomainRecords(),
+			"linode_domain_zone":         resourceLinodeDomainZone(),
+			"linode_image":               resourceLinodeImage(),
 			"linode_instance":            resourceLinodeInstance(),
+			"linode_instance_snapshot":   resourceLinodeInstanceSnapshot(),
+			"linode_ip_assignment":       resourceLinodeIPAssignment(),
 			"linode_nodebalancer":        resourceLinodeNodeBalancer(),
 			"linode_nodebalancer_config": resourceLinodeNodeBalancerConfig(),
 			"linode_nodebalancer_node":   resourceLinodeNodeBalancerNode(),
+			"linode_stackscript":         resourceLinodeStackscript(),
 			"linode_volume":              resourceLinodeVolume(),
 		},
 
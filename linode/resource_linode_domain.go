@@ -0,0 +1,332 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// domainSecondsValues are the values accepted by the API for the refresh, retry, expire and TTL
+// settings of a Domain. Any other value is rounded by the API to the nearest of these.
+var domainSecondsValues = []int{300, 3600, 7200, 14400, 28800, 57600, 86400, 172800, 345600, 604800, 1209600, 2419200}
+
+func resourceLinodeDomain() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLinodeDomainCreate,
+		Read:          resourceLinodeDomainRead,
+		Update:        resourceLinodeDomainUpdate,
+		Delete:        resourceLinodeDomainDelete,
+		Exists:        resourceLinodeDomainExists,
+		CustomizeDiff: resourceLinodeDomainCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The domain this Domain represents. These must be unique in our system; you cannot have two Domains representing the same domain.",
+				Required:    true,
+			},
+			"type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "If this Domain represents the authoritative source of information for the domain it describes (master), or if it is a read-only copy of a master (slave).",
+				Optional:     true,
+				Default:      string(linodego.DomainTypeMaster),
+				ValidateFunc: validateDomainType,
+			},
+			"soa_email": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "Start of Authority email address. This is required for master Domains, unless they are cloned.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"clone_from_domain_id": &schema.Schema{
+				Type:             schema.TypeInt,
+				Description:      "The ID of a Domain whose settings and records are cloned when this Domain is created. The clone is managed independently afterwards.",
+				Optional:         true,
+				DiffSuppressFunc: domainCloneDiffSuppressFunc,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A description for this Domain. This is for display purposes only.",
+				Optional:    true,
+			},
+			"status": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "Used to control whether this Domain is currently being rendered: active, disabled or edit_mode.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validateDomainStatus,
+			},
+			"master_ips": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The IP addresses representing the master DNS for this Domain. This is required for slave Domains.",
+				Optional:    true,
+			},
+			"axfr_ips": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "The list of IPs that may perform a zone transfer for this Domain. This is potentially dangerous, and should be left empty unless you intend to use it.",
+				Optional:    true,
+			},
+			"refresh_sec": domainSecondsSchema("The amount of time in seconds before this Domain should be refreshed."),
+			"retry_sec":   domainSecondsSchema("The interval, in seconds, at which a failed refresh should be retried."),
+			"expire_sec":  domainSecondsSchema("The amount of time in seconds that may pass before this Domain is no longer authoritative."),
+			"ttl_sec":     domainSecondsSchema("The amount of time in seconds that this Domain's records may be cached by resolvers or other domain servers."),
+		},
+	}
+}
+
+// domainSecondsSchema returns the schema of a Domain setting that the API rounds to one of domainSecondsValues
+func domainSecondsSchema(description string) *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeInt,
+		Description:      description + " Valid values are 300, 3600, 7200, 14400, 28800, 57600, 86400, 172800, 345600, 604800, 1209600, and 2419200 - any other value will be rounded to the nearest valid value.",
+		Optional:         true,
+		Computed:         true,
+		ValidateFunc:     validateDomainSeconds,
+		DiffSuppressFunc: domainSecondsDiffSuppressFunc,
+	}
+}
+
+// normalizeDomainSeconds rounds a value to the nearest value accepted by the API, preferring the
+// larger value on a tie. Zero is kept as it selects the default of the API.
+func normalizeDomainSeconds(seconds int) int {
+	if seconds == 0 {
+		return 0
+	}
+	nearest := domainSecondsValues[0]
+	for _, v := range domainSecondsValues[1:] {
+		if v >= seconds {
+			if v-seconds <= seconds-nearest {
+				nearest = v
+			}
+			break
+		}
+		nearest = v
+	}
+	return nearest
+}
+
+// domainSecondsDiffSuppressFunc ignores differences that disappear once the API has rounded the configured value
+func domainSecondsDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	o, err := strconv.Atoi(old)
+	if err != nil {
+		return false
+	}
+	n, err := strconv.Atoi(new)
+	if err != nil {
+		return false
+	}
+	return normalizeDomainSeconds(o) == normalizeDomainSeconds(n)
+}
+
+func validateDomainSeconds(v interface{}, k string) (ws []string, errors []error) {
+	if v.(int) < 0 {
+		errors = append(errors, fmt.Errorf("%q must not be negative", k))
+	}
+	return
+}
+
+func validateDomainType(v interface{}, k string) (ws []string, errors []error) {
+	switch linodego.DomainType(v.(string)) {
+	case linodego.DomainTypeMaster, linodego.DomainTypeSlave:
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of master or slave, got %s", k, v))
+	}
+	return
+}
+
+func validateDomainStatus(v interface{}, k string) (ws []string, errors []error) {
+	switch linodego.DomainStatus(v.(string)) {
+	case linodego.DomainStatusActive, linodego.DomainStatusDisabled, linodego.DomainStatusEditMode:
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of active, disabled or edit_mode, got %s", k, v))
+	}
+	return
+}
+
+// resourceLinodeDomainCustomizeDiff ensures master Domains have a soa_email and slave Domains have master_ips
+func resourceLinodeDomainCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	switch linodego.DomainType(d.Get("type").(string)) {
+	case linodego.DomainTypeMaster:
+		if _, clone := d.GetOk("clone_from_domain_id"); clone && d.Id() == "" {
+			return nil
+		}
+		if d.NewValueKnown("soa_email") && d.Get("soa_email").(string) == "" {
+			return fmt.Errorf("soa_email is required for master Linode Domains")
+		}
+	case linodego.DomainTypeSlave:
+		if d.NewValueKnown("master_ips") && d.Get("master_ips").(*schema.Set).Len() == 0 {
+			return fmt.Errorf("master_ips is required for slave Linode Domains")
+		}
+	}
+	return nil
+}
+
+// domainCloneDiffSuppressFunc ignores changes to the source of a clone once the Domain exists, as it is
+// only used when the Domain is created
+func domainCloneDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	return d.Id() != ""
+}
+
+func resourceLinodeDomainExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+
+	_, err = client.GetDomain(context.TODO(), int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Failed to get Linode Domain %s because %s", d.Id(), err)
+	}
+	return true, nil
+}
+
+func syncDomainResourceData(d *schema.ResourceData, domain *linodego.Domain) {
+	d.Set("domain", domain.Domain)
+	d.Set("type", string(domain.Type))
+	d.Set("soa_email", domain.SOAEmail)
+	d.Set("description", domain.Description)
+	d.Set("status", string(domain.Status))
+	d.Set("master_ips", domain.MasterIPs)
+	d.Set("axfr_ips", domain.AXfrIPs)
+	d.Set("refresh_sec", domain.RefreshSec)
+	d.Set("retry_sec", domain.RetrySec)
+	d.Set("expire_sec", domain.ExpireSec)
+	d.Set("ttl_sec", domain.TTLSec)
+}
+
+func resourceLinodeDomainRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+
+	domain, err := client.GetDomain(context.TODO(), int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Linode Domain %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to find the specified Linode Domain because %s", err)
+	}
+
+	syncDomainResourceData(d, domain)
+
+	return nil
+}
+
+func resourceLinodeDomainCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Domain")
+	}
+
+	if sourceID, ok := d.GetOk("clone_from_domain_id"); ok {
+		cloneOpts := linodego.DomainCloneOptions{Domain: d.Get("domain").(string)}
+		log.Printf("[INFO] Cloning Linode Domain %d to %s", sourceID, cloneOpts.Domain)
+		domain, err := client.CloneDomain(context.TODO(), sourceID.(int), cloneOpts)
+		if err != nil {
+			return fmt.Errorf("Failed to clone Linode Domain %d because %s", sourceID, err)
+		}
+		d.SetId(fmt.Sprintf("%d", domain.ID))
+
+		// The clone starts with the settings of its source, the configured settings are applied over them
+		return resourceLinodeDomainUpdate(d, meta)
+	}
+
+	createOpts := linodego.DomainCreateOptions{
+		Domain:      d.Get("domain").(string),
+		Type:        linodego.DomainType(d.Get("type").(string)),
+		Status:      linodego.DomainStatus(d.Get("status").(string)),
+		Description: d.Get("description").(string),
+		SOAEmail:    d.Get("soa_email").(string),
+		MasterIPs:   expandStringSet(d.Get("master_ips").(*schema.Set)),
+		AXfrIPs:     expandStringSet(d.Get("axfr_ips").(*schema.Set)),
+		RefreshSec:  normalizeDomainSeconds(d.Get("refresh_sec").(int)),
+		RetrySec:    normalizeDomainSeconds(d.Get("retry_sec").(int)),
+		ExpireSec:   normalizeDomainSeconds(d.Get("expire_sec").(int)),
+		TTLSec:      normalizeDomainSeconds(d.Get("ttl_sec").(int)),
+	}
+
+	log.Printf("[INFO] Creating Linode Domain %s", createOpts.Domain)
+	domain, err := client.CreateDomain(context.TODO(), &createOpts)
+	if err != nil {
+		return fmt.Errorf("Failed to create a Linode Domain because %s", err)
+	}
+	d.SetId(fmt.Sprintf("%d", domain.ID))
+
+	return resourceLinodeDomainRead(d, meta)
+}
+
+func resourceLinodeDomainUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+
+	domain, err := client.GetDomain(context.TODO(), int(id))
+	if err != nil {
+		return fmt.Errorf("Failed to fetch data about the current Linode Domain because %s", err)
+	}
+
+	updateOpts := domain.GetUpdateOptions()
+	updateOpts.Domain = d.Get("domain").(string)
+	updateOpts.Type = linodego.DomainType(d.Get("type").(string))
+	updateOpts.Status = linodego.DomainStatus(d.Get("status").(string))
+	description := d.Get("description").(string)
+	updateOpts.Description = &description
+	updateOpts.SOAEmail = d.Get("soa_email").(string)
+	updateOpts.MasterIPs = expandStringSet(d.Get("master_ips").(*schema.Set))
+	updateOpts.AXfrIPs = expandStringSet(d.Get("axfr_ips").(*schema.Set))
+	updateOpts.RefreshSec = normalizeDomainSeconds(d.Get("refresh_sec").(int))
+	updateOpts.RetrySec = normalizeDomainSeconds(d.Get("retry_sec").(int))
+	updateOpts.ExpireSec = normalizeDomainSeconds(d.Get("expire_sec").(int))
+	updateOpts.TTLSec = normalizeDomainSeconds(d.Get("ttl_sec").(int))
+
+	if domain, err = client.UpdateDomain(context.TODO(), domain.ID, updateOpts); err != nil {
+		return fmt.Errorf("Failed to update Linode Domain %d because %s", id, err)
+	}
+	syncDomainResourceData(d, domain)
+
+	return nil
+}
+
+func resourceLinodeDomainDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+	if err := client.DeleteDomain(context.TODO(), int(id)); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Failed to delete Linode Domain %d because %s", id, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// expandStringSet converts a set of strings into a non-nil slice of strings
+func expandStringSet(set *schema.Set) []string {
+	return expandStringList(set.List())
+}
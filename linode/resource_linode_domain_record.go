@@ -0,0 +1,404 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"strconv"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// domainRecordTypes are the record types that can be managed with linode_domain_record
+var domainRecordTypes = []linodego.DomainRecordType{
+	linodego.RecordTypeA,
+	linodego.RecordTypeAAAA,
+	linodego.RecordTypeNS,
+	linodego.RecordTypeMX,
+	linodego.RecordTypeCNAME,
+	linodego.RecordTypeTXT,
+	linodego.RecordTypeSRV,
+	linodego.RecordTypeCAA,
+}
+
+// domainRecordFields are the arguments describing a record, shared with linode_domain_records
+var domainRecordFields = []string{"record_type", "name", "target", "priority", "weight", "port", "service", "protocol", "tag", "ttl_sec"}
+
+func resourceLinodeDomainRecord() *schema.Resource {
+	s := domainRecordSchema()
+	s["domain_id"] = &schema.Schema{
+		Type:        schema.TypeInt,
+		Description: "The ID of the Domain to access.",
+		Required:    true,
+		ForceNew:    true,
+	}
+	s["record_type"].ForceNew = true
+
+	return &schema.Resource{
+		Create:        resourceLinodeDomainRecordCreate,
+		Read:          resourceLinodeDomainRecordRead,
+		Update:        resourceLinodeDomainRecordUpdate,
+		Delete:        resourceLinodeDomainRecordDelete,
+		Exists:        resourceLinodeDomainRecordExists,
+		CustomizeDiff: resourceLinodeDomainRecordCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeDomainRecordImport,
+		},
+		Schema: s,
+	}
+}
+
+// domainRecordSchema returns the arguments describing a single record
+func domainRecordSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"record_type": &schema.Schema{
+			Type:         schema.TypeString,
+			Description:  "The type of Record this is in the DNS system: A, AAAA, NS, MX, CNAME, TXT, SRV or CAA.",
+			Required:     true,
+			ValidateFunc: validateDomainRecordType,
+		},
+		"name": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The name of this Record. Leave empty for a Record of the Domain itself.",
+			Optional:    true,
+		},
+		"target": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The target for this Record. This field's actual usage depends on the type of record this represents. For A and AAAA records, this is the address the named Domain should resolve to.",
+			Required:    true,
+		},
+		"priority": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The priority of the target host. Lower values are preferred. Only valid for MX and SRV records.",
+			Optional:    true,
+		},
+		"weight": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The relative weight of this Record. Higher values are preferred. Only valid for SRV records.",
+			Optional:    true,
+		},
+		"port": &schema.Schema{
+			Type:        schema.TypeInt,
+			Description: "The port this Record points to. Only valid for SRV records.",
+			Optional:    true,
+		},
+		"service": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The service this Record identified, without the leading underscore. Required for SRV records.",
+			Optional:    true,
+		},
+		"protocol": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The protocol this Record's service communicates with, without the leading underscore. Required for SRV records.",
+			Optional:    true,
+		},
+		"tag": &schema.Schema{
+			Type:        schema.TypeString,
+			Description: "The tag portion of a CAA record: issue, issuewild or iodef. Required for CAA records.",
+			Optional:    true,
+		},
+		"ttl_sec": domainSecondsSchema("\"Time to Live\" - the amount of time in seconds that this Record may be cached by resolvers or other domain servers."),
+	}
+}
+
+func validateDomainRecordType(v interface{}, k string) (ws []string, errors []error) {
+	for _, t := range domainRecordTypes {
+		if string(t) == v.(string) {
+			return
+		}
+	}
+	errors = append(errors, fmt.Errorf("%q must be one of A, AAAA, NS, MX, CNAME, TXT, SRV or CAA, got %s", k, v))
+	return
+}
+
+// expandDomainRecord builds a record from the arguments returned by get, which is the Get of a
+// schema.ResourceData, a schema.ResourceDiff or a nested record block
+func expandDomainRecord(get func(string) interface{}) *linodego.DomainRecord {
+	record := &linodego.DomainRecord{
+		Type:     linodego.DomainRecordType(get("record_type").(string)),
+		Name:     get("name").(string),
+		Target:   get("target").(string),
+		Priority: get("priority").(int),
+		Weight:   get("weight").(int),
+		Port:     get("port").(int),
+		TTLSec:   normalizeDomainSeconds(get("ttl_sec").(int)),
+	}
+	if service := get("service").(string); service != "" {
+		record.Service = &service
+	}
+	if protocol := get("protocol").(string); protocol != "" {
+		record.Protocol = &protocol
+	}
+	if tag := get("tag").(string); tag != "" {
+		record.Tag = &tag
+	}
+	return record
+}
+
+// flattenDomainRecord converts a record into a map of the arguments describing it
+func flattenDomainRecord(record *linodego.DomainRecord) map[string]interface{} {
+	result := map[string]interface{}{
+		"record_type": string(record.Type),
+		"name":        record.Name,
+		"target":      record.Target,
+		"priority":    record.Priority,
+		"weight":      record.Weight,
+		"port":        record.Port,
+		"service":     "",
+		"protocol":    "",
+		"tag":         "",
+		"ttl_sec":     record.TTLSec,
+	}
+	if record.Service != nil {
+		result["service"] = *record.Service
+	}
+	if record.Protocol != nil {
+		result["protocol"] = *record.Protocol
+	}
+	if record.Tag != nil {
+		result["tag"] = *record.Tag
+	}
+	return result
+}
+
+// validateDomainRecord checks that the fields of a record are valid for its type
+func validateDomainRecord(record *linodego.DomainRecord) error {
+	stringValue := func(s *string) string {
+		if s == nil {
+			return ""
+		}
+		return *s
+	}
+	service, protocol, tag := stringValue(record.Service), stringValue(record.Protocol), stringValue(record.Tag)
+
+	if record.Type != linodego.RecordTypeMX && record.Type != linodego.RecordTypeSRV && record.Priority != 0 {
+		return fmt.Errorf("priority is only valid for MX and SRV records, not %s", record.Type)
+	}
+	if record.Type != linodego.RecordTypeSRV && (record.Weight != 0 || record.Port != 0 || service != "" || protocol != "") {
+		return fmt.Errorf("weight, port, service and protocol are only valid for SRV records, not %s", record.Type)
+	}
+	if record.Type != linodego.RecordTypeCAA && tag != "" {
+		return fmt.Errorf("tag is only valid for CAA records, not %s", record.Type)
+	}
+	if record.Target == "" {
+		return fmt.Errorf("%s records require a target", record.Type)
+	}
+
+	switch record.Type {
+	case linodego.RecordTypeA:
+		if ip := net.ParseIP(record.Target); ip == nil || ip.To4() == nil {
+			return fmt.Errorf("the target of A records must be an IPv4 address, got %s", record.Target)
+		}
+	case linodego.RecordTypeAAAA:
+		if ip := net.ParseIP(record.Target); ip == nil || ip.To4() != nil {
+			return fmt.Errorf("the target of AAAA records must be an IPv6 address, got %s", record.Target)
+		}
+	case linodego.RecordTypeNS, linodego.RecordTypeMX, linodego.RecordTypeCNAME:
+		if net.ParseIP(record.Target) != nil {
+			return fmt.Errorf("the target of %s records must be a hostname, got %s", record.Type, record.Target)
+		}
+		if record.Priority < 0 || record.Priority > 255 {
+			return fmt.Errorf("priority must be between 0 and 255, got %d", record.Priority)
+		}
+	case linodego.RecordTypeSRV:
+		if service == "" || protocol == "" {
+			return fmt.Errorf("SRV records require a service and a protocol")
+		}
+		if strings.HasPrefix(service, "_") || strings.HasPrefix(protocol, "_") {
+			return fmt.Errorf("the service and protocol of SRV records must be given without the leading underscore")
+		}
+		for field, value := range map[string]int{"priority": record.Priority, "weight": record.Weight, "port": record.Port} {
+			if value < 0 || value > 65535 {
+				return fmt.Errorf("%s must be between 0 and 65535, got %d", field, value)
+			}
+		}
+	case linodego.RecordTypeCAA:
+		switch tag {
+		case "issue", "issuewild", "iodef":
+		default:
+			return fmt.Errorf("CAA records require a tag of issue, issuewild or iodef, got %q", tag)
+		}
+	}
+	return nil
+}
+
+// resourceLinodeDomainRecordCustomizeDiff validates the record for its type once all of its fields are known
+func resourceLinodeDomainRecordCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range domainRecordFields {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	return validateDomainRecord(expandDomainRecord(d.Get))
+}
+
+// domainRecordCreateOptions returns the options creating a record, only including the fields valid for its type
+func domainRecordCreateOptions(record *linodego.DomainRecord) linodego.DomainRecordCreateOptions {
+	opts := linodego.DomainRecordCreateOptions{
+		Type:   record.Type,
+		Name:   record.Name,
+		Target: record.Target,
+		TTLSec: record.TTLSec,
+	}
+	switch record.Type {
+	case linodego.RecordTypeMX:
+		opts.Priority = &record.Priority
+	case linodego.RecordTypeSRV:
+		opts.Priority = &record.Priority
+		opts.Weight = &record.Weight
+		opts.Port = &record.Port
+		opts.Service = record.Service
+		opts.Protocol = record.Protocol
+	case linodego.RecordTypeCAA:
+		opts.Tag = record.Tag
+	}
+	return opts
+}
+
+// domainRecordUpdateOptions returns the options updating a record, only including the fields valid for its type
+func domainRecordUpdateOptions(record *linodego.DomainRecord) linodego.DomainRecordUpdateOptions {
+	createOpts := domainRecordCreateOptions(record)
+	return linodego.DomainRecordUpdateOptions{
+		Name:     createOpts.Name,
+		Target:   createOpts.Target,
+		Priority: createOpts.Priority,
+		Weight:   createOpts.Weight,
+		Port:     createOpts.Port,
+		Service:  createOpts.Service,
+		Protocol: createOpts.Protocol,
+		TTLSec:   createOpts.TTLSec,
+		Tag:      createOpts.Tag,
+	}
+}
+
+func resourceLinodeDomainRecordExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Failed to parse Linode Domain Record ID %s as int because %s", d.Id(), err)
+	}
+	domainID := d.Get("domain_id").(int)
+
+	_, err = client.GetDomainRecord(context.TODO(), domainID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Failed to get record %d of Linode Domain %d because %s", id, domainID, err)
+	}
+	return true, nil
+}
+
+func syncDomainRecordResourceData(d *schema.ResourceData, record *linodego.DomainRecord) {
+	for k, v := range flattenDomainRecord(record) {
+		d.Set(k, v)
+	}
+}
+
+func resourceLinodeDomainRecordRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain Record ID %s as int because %s", d.Id(), err)
+	}
+	domainID := d.Get("domain_id").(int)
+
+	record, err := client.GetDomainRecord(context.TODO(), domainID, int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Record %d of Linode Domain %d no longer exists", id, domainID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to find record %d of Linode Domain %d because %s", id, domainID, err)
+	}
+
+	syncDomainRecordResourceData(d, record)
+
+	return nil
+}
+
+func resourceLinodeDomainRecordCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Domain Record")
+	}
+	domainID := d.Get("domain_id").(int)
+
+	record := expandDomainRecord(d.Get)
+	if err := validateDomainRecord(record); err != nil {
+		return err
+	}
+
+	createOpts := domainRecordCreateOptions(record)
+	log.Printf("[INFO] Creating %s record %q of Linode Domain %d", record.Type, record.Name, domainID)
+	record, err := client.CreateDomainRecord(context.TODO(), domainID, &createOpts)
+	if err != nil {
+		return fmt.Errorf("Failed to create a record of Linode Domain %d because %s", domainID, err)
+	}
+	d.SetId(fmt.Sprintf("%d", record.ID))
+
+	return resourceLinodeDomainRecordRead(d, meta)
+}
+
+func resourceLinodeDomainRecordUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain Record ID %s as int because %s", d.Id(), err)
+	}
+	domainID := d.Get("domain_id").(int)
+
+	record := expandDomainRecord(d.Get)
+	if err := validateDomainRecord(record); err != nil {
+		return err
+	}
+
+	if record, err = client.UpdateDomainRecord(context.TODO(), domainID, int(id), domainRecordUpdateOptions(record)); err != nil {
+		return fmt.Errorf("Failed to update record %d of Linode Domain %d because %s", id, domainID, err)
+	}
+	syncDomainRecordResourceData(d, record)
+
+	return nil
+}
+
+func resourceLinodeDomainRecordDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain Record ID %s as int because %s", d.Id(), err)
+	}
+	domainID := d.Get("domain_id").(int)
+
+	if err := client.DeleteDomainRecord(context.TODO(), domainID, int(id)); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Failed to delete record %d of Linode Domain %d because %s", id, domainID, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceLinodeDomainRecordImport imports a record given as "domain_id,record_id"
+func resourceLinodeDomainRecordImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Failed to import Linode Domain Record %s because the ID must be given as domain_id,record_id", d.Id())
+	}
+
+	domainID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", parts[0], err)
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Failed to parse Linode Domain Record ID %s as int because %s", parts[1], err)
+	}
+
+	d.SetId(parts[1])
+	d.Set("domain_id", domainID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,245 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestValidateDomainRecord(t *testing.T) {
+	t.Parallel()
+
+	service, protocol, tag, badTag := "sip", "tcp", "issue", "policy"
+	cases := []struct {
+		record linodego.DomainRecord
+		valid  bool
+	}{
+		{linodego.DomainRecord{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeA, Name: "www", Target: "2001:db8::1"}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeAAAA, Name: "www", Target: "2001:db8::1"}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeAAAA, Name: "www", Target: "192.0.2.1"}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeCNAME, Name: "www", Target: "example.com"}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeCNAME, Name: "www", Target: "192.0.2.1"}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeMX, Target: "mail.example.com", Priority: 10}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeMX, Target: "mail.example.com", Priority: 300}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeA, Target: "192.0.2.1", Priority: 10}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeTXT, Target: "v=spf1 -all"}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeTXT}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeSRV, Target: "sip.example.com", Service: &service, Protocol: &protocol, Port: 5060}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeSRV, Target: "sip.example.com", Port: 5060}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeA, Target: "192.0.2.1", Service: &service}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeCAA, Target: "letsencrypt.org", Tag: &tag}, true},
+		{linodego.DomainRecord{Type: linodego.RecordTypeCAA, Target: "letsencrypt.org", Tag: &badTag}, false},
+		{linodego.DomainRecord{Type: linodego.RecordTypeCAA, Target: "letsencrypt.org"}, false},
+	}
+
+	for i, tc := range cases {
+		err := validateDomainRecord(&tc.record)
+		if tc.valid && err != nil {
+			t.Errorf("expected case %d to be valid, got %s", i, err)
+		}
+		if !tc.valid && err == nil {
+			t.Errorf("expected case %d to be invalid", i)
+		}
+	}
+}
+
+func TestAccLinodeDomainRecordBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain_record.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainRecordExists,
+					resource.TestCheckResourceAttr(resName, "name", "www"),
+					resource.TestCheckResourceAttr(resName, "record_type", "A"),
+					resource.TestCheckResourceAttr(resName, "target", "192.0.2.1"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: testAccLinodeDomainRecordImportStateID(resName),
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomainRecordUpdate(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain_record.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainRecordExists,
+					resource.TestCheckResourceAttr(resName, "name", "www"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordConfigUpdates(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainRecordExists,
+					resource.TestCheckResourceAttr(resName, "name", "web"),
+					resource.TestCheckResourceAttr(resName, "target", "192.0.2.2"),
+					resource.TestCheckResourceAttr(resName, "ttl_sec", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomainRecordSRV(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain_record.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainRecordDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordConfigSRV(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainRecordExists,
+					resource.TestCheckResourceAttr(resName, "service", "sip"),
+					resource.TestCheckResourceAttr(resName, "protocol", "tcp"),
+					resource.TestCheckResourceAttr(resName, "port", "5060"),
+					resource.TestCheckResourceAttr(resName, "weight", "5"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLinodeDomainRecordImportStateID(resName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return "", fmt.Errorf("Could not find %s in the state", resName)
+		}
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["domain_id"], rs.Primary.ID), nil
+	}
+}
+
+func testAccCheckLinodeDomainRecordExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(linodego.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_domain_record" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+		domainID, err := strconv.Atoi(rs.Primary.Attributes["domain_id"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.Attributes["domain_id"])
+		}
+
+		_, err = client.GetDomainRecord(context.Background(), domainID, id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Domain Record %s: %s", rs.Primary.Attributes["name"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeDomainRecordDestroy(s *terraform.State) error {
+	client, ok := testAccProvider.Meta().(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Failed to get Linode client")
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_domain_record" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+		domainID, err := strconv.Atoi(rs.Primary.Attributes["domain_id"])
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.Attributes["domain_id"])
+		}
+
+		_, err = client.GetDomainRecord(context.Background(), domainID, id)
+
+		if err == nil {
+			return fmt.Errorf("Linode Domain Record with id %d still exists", id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Failed to request Linode Domain Record with id %d", id)
+		}
+	}
+
+	return testAccCheckLinodeDomainDestroy(s)
+}
+
+func testAccCheckLinodeDomainRecordConfigBasic(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+resource "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	name = "www"
+	record_type = "A"
+	target = "192.0.2.1"
+}`
+}
+
+func testAccCheckLinodeDomainRecordConfigUpdates(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+resource "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	name = "web"
+	record_type = "A"
+	target = "192.0.2.2"
+	ttl_sec = 3000
+}`
+}
+
+func testAccCheckLinodeDomainRecordConfigSRV(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+resource "linode_domain_record" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	record_type = "SRV"
+	service = "sip"
+	protocol = "tcp"
+	target = "sip.example.com"
+	priority = 10
+	weight = 5
+	port = 5060
+}`
+}
@@ -0,0 +1,302 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLinodeDomainRecords() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLinodeDomainRecordsCreate,
+		Read:          resourceLinodeDomainRecordsRead,
+		Update:        resourceLinodeDomainRecordsUpdate,
+		Delete:        resourceLinodeDomainRecordsDelete,
+		CustomizeDiff: resourceLinodeDomainRecordsCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"domain_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Domain whose records are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"record": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The complete set of records of the Domain. Records that are not in this set and not excluded are deleted.",
+				Optional:    true,
+				Set:         domainRecordHash,
+				Elem: &schema.Resource{
+					Schema: domainRecordSetSchema(),
+				},
+			},
+			"exclude_types": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Record types, e.g. NS, that are left untouched and not managed by this resource.",
+				Optional:    true,
+			},
+			"exclude_names": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Record names that are left untouched and not managed by this resource.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// domainRecordSetSchema returns the schema of a record in a set. The TTL is not computed, zero
+// selects the default TTL of the Domain.
+func domainRecordSetSchema() map[string]*schema.Schema {
+	s := domainRecordSchema()
+	s["ttl_sec"].Computed = false
+	s["ttl_sec"].DiffSuppressFunc = nil
+	return s
+}
+
+// domainRecordHash hashes a record of a set by its normalized fields, so a configured TTL that
+// the API rounds does not appear as a different record
+func domainRecordHash(v interface{}) int {
+	m := v.(map[string]interface{})
+	record := expandDomainRecord(func(k string) interface{} { return m[k] })
+
+	var buf bytes.Buffer
+	buf.WriteString(fmt.Sprintf("%s-", domainRecordKey(record)))
+	buf.WriteString(fmt.Sprintf("%d-", record.TTLSec))
+	return hashcode.String(buf.String())
+}
+
+// domainRecordKey identifies the content of a record, ignoring its ID and TTL
+func domainRecordKey(record *linodego.DomainRecord) string {
+	fields := flattenDomainRecord(record)
+	return fmt.Sprintf("%s|%s|%s|%d|%d|%d|%s|%s|%s", record.Type, record.Name, record.Target,
+		record.Priority, record.Weight, record.Port, fields["service"], fields["protocol"], fields["tag"])
+}
+
+// domainRecordExclusions are the record types and names left untouched by linode_domain_records
+type domainRecordExclusions struct {
+	types map[string]bool
+	names map[string]bool
+}
+
+// expandDomainRecordExclusions builds the exclusions from the arguments returned by get, which is the
+// Get of a schema.ResourceData or a schema.ResourceDiff
+func expandDomainRecordExclusions(get func(string) interface{}) domainRecordExclusions {
+	e := domainRecordExclusions{types: map[string]bool{}, names: map[string]bool{}}
+	for _, t := range get("exclude_types").(*schema.Set).List() {
+		e.types[t.(string)] = true
+	}
+	for _, n := range get("exclude_names").(*schema.Set).List() {
+		e.names[n.(string)] = true
+	}
+	return e
+}
+
+// managed returns the records that are not excluded
+func (e domainRecordExclusions) managed(records []*linodego.DomainRecord) []*linodego.DomainRecord {
+	var result []*linodego.DomainRecord
+	for _, record := range records {
+		if e.types[string(record.Type)] || e.names[record.Name] {
+			continue
+		}
+		result = append(result, record)
+	}
+	return result
+}
+
+// domainRecordChanges are the API calls converging the records of a Domain to the desired set.
+// Updated records carry the ID of the existing record they replace.
+type domainRecordChanges struct {
+	create []*linodego.DomainRecord
+	update []*linodego.DomainRecord
+	delete []*linodego.DomainRecord
+}
+
+// planDomainRecordChanges diffs the existing records against the desired records. Records with
+// identical content are kept, records of the same type and name are updated in place and the
+// remaining records are created or deleted.
+func planDomainRecordChanges(existing, desired []*linodego.DomainRecord) domainRecordChanges {
+	var changes domainRecordChanges
+
+	kept := map[*linodego.DomainRecord]bool{}
+	var remaining []*linodego.DomainRecord
+	for _, record := range desired {
+		match := findDomainRecord(existing, kept, func(candidate *linodego.DomainRecord) bool {
+			return domainRecordKey(candidate) == domainRecordKey(record)
+		})
+		if match == nil {
+			remaining = append(remaining, record)
+			continue
+		}
+		kept[match] = true
+		if record.TTLSec != match.TTLSec {
+			updated := *record
+			updated.ID = match.ID
+			changes.update = append(changes.update, &updated)
+		}
+	}
+
+	for _, record := range remaining {
+		match := findDomainRecord(existing, kept, func(candidate *linodego.DomainRecord) bool {
+			return candidate.Type == record.Type && candidate.Name == record.Name
+		})
+		if match == nil {
+			changes.create = append(changes.create, record)
+			continue
+		}
+		kept[match] = true
+		updated := *record
+		updated.ID = match.ID
+		changes.update = append(changes.update, &updated)
+	}
+
+	for _, record := range existing {
+		if !kept[record] {
+			changes.delete = append(changes.delete, record)
+		}
+	}
+
+	return changes
+}
+
+// findDomainRecord returns the first record that is not yet kept and matches
+func findDomainRecord(records []*linodego.DomainRecord, kept map[*linodego.DomainRecord]bool, matches func(*linodego.DomainRecord) bool) *linodego.DomainRecord {
+	for _, record := range records {
+		if !kept[record] && matches(record) {
+			return record
+		}
+	}
+	return nil
+}
+
+// resourceLinodeDomainRecordsCustomizeDiff validates every record for its type once the set is known
+func resourceLinodeDomainRecordsCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("record") {
+		return nil
+	}
+	for _, r := range d.Get("record").(*schema.Set).List() {
+		m := r.(map[string]interface{})
+		record := expandDomainRecord(func(k string) interface{} { return m[k] })
+		if err := validateDomainRecord(record); err != nil {
+			return fmt.Errorf("Invalid %s record %q: %s", record.Type, record.Name, err)
+		}
+	}
+	return nil
+}
+
+func resourceLinodeDomainRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Linode Domain %d no longer exists", domainID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+
+	var result []map[string]interface{}
+	for _, record := range expandDomainRecordExclusions(d.Get).managed(records) {
+		result = append(result, flattenDomainRecord(record))
+	}
+
+	d.Set("domain_id", domainID)
+	d.Set("record", result)
+
+	return nil
+}
+
+func resourceLinodeDomainRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%d", d.Get("domain_id").(int)))
+	return resourceLinodeDomainRecordsUpdate(d, meta)
+}
+
+func resourceLinodeDomainRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+
+	var desired []*linodego.DomainRecord
+	for _, r := range d.Get("record").(*schema.Set).List() {
+		m := r.(map[string]interface{})
+		record := expandDomainRecord(func(k string) interface{} { return m[k] })
+		if err := validateDomainRecord(record); err != nil {
+			return fmt.Errorf("Invalid %s record %q: %s", record.Type, record.Name, err)
+		}
+		desired = append(desired, record)
+	}
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+	if err := syncDomainRecords(&client, domainID, expandDomainRecordExclusions(d.Get).managed(records), desired); err != nil {
+		return err
+	}
+
+	return resourceLinodeDomainRecordsRead(d, meta)
+}
+
+// syncDomainRecords converges the existing managed records of a Domain to the desired records
+func syncDomainRecords(client *linodego.Client, domainID int, existing, desired []*linodego.DomainRecord) error {
+	changes := planDomainRecordChanges(existing, desired)
+
+	// Deleting first frees the names of records replaced by a record of another type, e.g. a CNAME
+	for _, record := range changes.delete {
+		log.Printf("[INFO] Deleting %s record %q of Linode Domain %d", record.Type, record.Name, domainID)
+		if err := client.DeleteDomainRecord(context.TODO(), domainID, record.ID); err != nil {
+			if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+				return fmt.Errorf("Failed to delete record %d of Linode Domain %d because %s", record.ID, domainID, err)
+			}
+		}
+	}
+	for _, record := range changes.update {
+		log.Printf("[INFO] Updating %s record %q of Linode Domain %d", record.Type, record.Name, domainID)
+		if _, err := client.UpdateDomainRecord(context.TODO(), domainID, record.ID, domainRecordUpdateOptions(record)); err != nil {
+			return fmt.Errorf("Failed to update record %d of Linode Domain %d because %s", record.ID, domainID, err)
+		}
+	}
+	for _, record := range changes.create {
+		log.Printf("[INFO] Creating %s record %q of Linode Domain %d", record.Type, record.Name, domainID)
+		createOpts := domainRecordCreateOptions(record)
+		if _, err := client.CreateDomainRecord(context.TODO(), domainID, &createOpts); err != nil {
+			return fmt.Errorf("Failed to create %s record %q of Linode Domain %d because %s", record.Type, record.Name, domainID, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceLinodeDomainRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+	if err := syncDomainRecords(&client, domainID, expandDomainRecordExclusions(d.Get).managed(records), nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
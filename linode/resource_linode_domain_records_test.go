@@ -0,0 +1,244 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestPlanDomainRecordChanges(t *testing.T) {
+	t.Parallel()
+
+	existing := []*linodego.DomainRecord{
+		{ID: 1, Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+		{ID: 2, Type: linodego.RecordTypeA, Name: "api", Target: "192.0.2.2", TTLSec: 300},
+		{ID: 3, Type: linodego.RecordTypeMX, Target: "mail.example.com", Priority: 10},
+		{ID: 4, Type: linodego.RecordTypeTXT, Name: "stale", Target: "hand-added"},
+	}
+	desired := []*linodego.DomainRecord{
+		{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+		{Type: linodego.RecordTypeA, Name: "api", Target: "192.0.2.2", TTLSec: 3600},
+		{Type: linodego.RecordTypeMX, Target: "mail2.example.com", Priority: 20},
+		{Type: linodego.RecordTypeAAAA, Name: "www", Target: "2001:db8::1"},
+	}
+
+	changes := planDomainRecordChanges(existing, desired)
+
+	if len(changes.create) != 1 || changes.create[0].Type != linodego.RecordTypeAAAA {
+		t.Errorf("expected the AAAA record to be created, got %v", changes.create)
+	}
+	if len(changes.update) != 2 {
+		t.Fatalf("expected 2 updates, got %d", len(changes.update))
+	}
+	if changes.update[0].ID != 2 || changes.update[0].TTLSec != 3600 {
+		t.Errorf("expected record 2 to be updated with the new TTL, got %v", changes.update[0])
+	}
+	if changes.update[1].ID != 3 || changes.update[1].Target != "mail2.example.com" {
+		t.Errorf("expected record 3 to be updated in place, got %v", changes.update[1])
+	}
+	if len(changes.delete) != 1 || changes.delete[0].ID != 4 {
+		t.Errorf("expected record 4 to be deleted, got %v", changes.delete)
+	}
+}
+
+func TestDomainRecordExclusionsManaged(t *testing.T) {
+	t.Parallel()
+
+	records := []*linodego.DomainRecord{
+		{ID: 1, Type: linodego.RecordTypeNS, Target: "ns1.linode.com"},
+		{ID: 2, Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1"},
+		{ID: 3, Type: linodego.RecordTypeTXT, Name: "_acme-challenge", Target: "token"},
+	}
+	exclusions := domainRecordExclusions{
+		types: map[string]bool{"NS": true},
+		names: map[string]bool{"_acme-challenge": true},
+	}
+
+	managed := exclusions.managed(records)
+	if len(managed) != 1 || managed[0].ID != 2 {
+		t.Errorf("expected only record 2 to be managed, got %v", managed)
+	}
+}
+
+func TestListDomainRecordsPaged(t *testing.T) {
+	t.Parallel()
+
+	pages := map[string]string{
+		"1": `{"page": 1, "pages": 2, "results": 3, "data": [{"id": 1, "type": "A", "name": "www"}, {"id": 2, "type": "A", "name": "api"}]}`,
+		"2": `{"page": 2, "pages": 2, "results": 3, "data": [{"id": 3, "type": "MX", "name": ""}]}`,
+	}
+
+	for _, failing := range []bool{false, true} {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			page := r.URL.Query().Get("page")
+			if page == "" {
+				page = "1"
+			}
+			w.Header().Set("Content-Type", "application/json")
+			if r.URL.Path != "/domains/1234/records" || (failing && page == "2") {
+				w.WriteHeader(http.StatusInternalServerError)
+				fmt.Fprint(w, `{"errors": [{"reason": "Please try again"}]}`)
+				return
+			}
+			fmt.Fprint(w, pages[page])
+		}))
+
+		client := linodego.NewClient(server.Client())
+		client.SetBaseURL(server.URL)
+		records, err := client.ListDomainRecords(context.Background(), 1234, nil)
+		server.Close()
+
+		if failing {
+			if err == nil {
+				t.Errorf("expected an error when a later page fails, got %d records", len(records))
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error %s", err)
+		}
+		if len(records) != 3 || records[0].ID != 1 || records[2].ID != 3 {
+			t.Errorf("expected the records of both pages, got %+v", records)
+		}
+	}
+}
+
+func TestAccLinodeDomainRecordsBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain_records.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainRecordsConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "2"),
+				),
+			},
+			resource.TestStep{
+				PreConfig: testAccCreateLinodeDomainRecord(domainName, "drift"),
+				Config:    testAccCheckLinodeDomainRecordsConfigUpdates(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "3"),
+					testAccCheckLinodeDomainRecordsCount(resName, 3),
+				),
+			},
+
+			// The exclusions are not imported, so the imported record set also holds the NS records
+			resource.TestStep{
+				ResourceName: resName,
+				ImportState:  true,
+			},
+		},
+	})
+}
+
+// testAccCreateLinodeDomainRecord adds a record by hand, which linode_domain_records must remove
+func testAccCreateLinodeDomainRecord(domainName, name string) func() {
+	return func() {
+		client := testAccProvider.Meta().(linodego.Client)
+		domains, err := client.ListDomains(context.Background(), nil)
+		if err != nil {
+			panic(err)
+		}
+		for _, domain := range domains {
+			if domain.Domain != domainName {
+				continue
+			}
+			opts := linodego.DomainRecordCreateOptions{Type: linodego.RecordTypeTXT, Name: name, Target: "hand-added"}
+			if _, err := client.CreateDomainRecord(context.Background(), domain.ID, &opts); err != nil {
+				panic(err)
+			}
+		}
+	}
+}
+
+// testAccCheckLinodeDomainRecordsCount checks the number of records of the Domain that are not NS records
+func testAccCheckLinodeDomainRecordsCount(resName string, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(linodego.Client)
+		rs, ok := s.RootModule().Resources[resName]
+		if !ok {
+			return fmt.Errorf("Could not find %s in the state", resName)
+		}
+
+		var domainID int
+		fmt.Sscanf(rs.Primary.ID, "%d", &domainID)
+		records, err := client.ListDomainRecords(context.Background(), domainID, nil)
+		if err != nil {
+			return err
+		}
+
+		found := 0
+		for _, record := range records {
+			if record.Type != linodego.RecordTypeNS {
+				found++
+			}
+		}
+		if found != count {
+			return fmt.Errorf("Expected %d records, found %d", count, found)
+		}
+		return nil
+	}
+}
+
+func testAccCheckLinodeDomainRecordsConfigBasic(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+resource "linode_domain_records" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	exclude_types = ["NS"]
+
+	record {
+		name = "www"
+		record_type = "A"
+		target = "192.0.2.1"
+	}
+
+	record {
+		record_type = "MX"
+		target = "mail.example.com"
+		priority = 10
+	}
+}`
+}
+
+func testAccCheckLinodeDomainRecordsConfigUpdates(domain string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + `
+
+resource "linode_domain_records" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	exclude_types = ["NS"]
+
+	record {
+		name = "www"
+		record_type = "A"
+		target = "192.0.2.2"
+		ttl_sec = 3600
+	}
+
+	record {
+		record_type = "MX"
+		target = "mail.example.com"
+		priority = 10
+	}
+
+	record {
+		name = "www"
+		record_type = "AAAA"
+		target = "2001:db8::1"
+	}
+}`
+}
@@ -0,0 +1,262 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestNormalizeDomainSeconds(t *testing.T) {
+	t.Parallel()
+
+	cases := map[int]int{
+		0:        0,
+		1:        300,
+		30:       300,
+		300:      300,
+		1950:     3600,
+		1949:     300,
+		86000:    86400,
+		700000:   604800,
+		2419200:  2419200,
+		99999999: 2419200,
+	}
+
+	for seconds, expected := range cases {
+		if normalized := normalizeDomainSeconds(seconds); normalized != expected {
+			t.Errorf("expected %d to be normalized to %d, got %d", seconds, expected, normalized)
+		}
+	}
+}
+
+func TestDomainSecondsDiffSuppressFunc(t *testing.T) {
+	t.Parallel()
+
+	if !domainSecondsDiffSuppressFunc("ttl_sec", "300", "30", nil) {
+		t.Errorf("should suppress a difference that the API rounds away")
+	}
+	if domainSecondsDiffSuppressFunc("ttl_sec", "300", "3600", nil) {
+		t.Errorf("should not suppress a difference between valid values")
+	}
+}
+
+func TestAccLinodeDomainBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "domain", domainName),
+					resource.TestCheckResourceAttr(resName, "type", "master"),
+					resource.TestCheckResourceAttr(resName, "status", "active"),
+					resource.TestCheckResourceAttr(resName, "ttl_sec", "300"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomainUpdate(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainConfigBasic(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "domain", domainName),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainConfigUpdates(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "description", "updated"),
+					resource.TestCheckResourceAttr(resName, "refresh_sec", "3600"),
+					resource.TestCheckResourceAttr(resName, "axfr_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomainSlave(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainConfigSlave(domainName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "type", "slave"),
+					resource.TestCheckResourceAttr(resName, "master_ips.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeDomainClone(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain.clone"
+	var templateName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+	var cloneName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainConfigClone(templateName, cloneName, "foobar"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeDomainExists,
+					resource.TestCheckResourceAttr(resName, "domain", cloneName),
+					resource.TestCheckResourceAttr(resName, "soa_email", fmt.Sprintf("admin@%s", templateName)),
+					resource.TestCheckResourceAttr(resName, "description", "vanity"),
+					testAccCheckLinodeDomainRecordsCount(resName, 1),
+				),
+			},
+			// Changing the source of the clone afterwards does not plan a new Domain
+			resource.TestStep{
+				Config:   testAccCheckLinodeDomainConfigClone(templateName, cloneName, "other"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeDomainExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(linodego.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_domain" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+
+		_, err = client.GetDomain(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of Domain %s: %s", rs.Primary.Attributes["domain"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeDomainDestroy(s *terraform.State) error {
+	client, ok := testAccProvider.Meta().(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Failed to get Linode client")
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_domain" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+
+		_, err = client.GetDomain(context.Background(), id)
+
+		if err == nil {
+			return fmt.Errorf("Linode Domain with id %d still exists", id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Failed to request Linode Domain with id %d", id)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeDomainConfigBasic(domain string) string {
+	return fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "master"
+	soa_email = "admin@%s"
+	ttl_sec = 30
+}`, domain, domain)
+}
+
+func testAccCheckLinodeDomainConfigUpdates(domain string) string {
+	return fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "master"
+	soa_email = "admin@%s"
+	description = "updated"
+	ttl_sec = 30
+	refresh_sec = 3000
+	axfr_ips = ["192.0.2.10"]
+}`, domain, domain)
+}
+
+func testAccCheckLinodeDomainConfigSlave(domain string) string {
+	return fmt.Sprintf(`
+resource "linode_domain" "foobar" {
+	domain = "%s"
+	type = "slave"
+	master_ips = ["192.0.2.1"]
+}`, domain)
+}
+
+func testAccCheckLinodeDomainConfigClone(template, clone, source string) string {
+	return testAccCheckLinodeDomainRecordConfigBasic(template) + fmt.Sprintf(`
+
+resource "linode_domain" "other" {
+	domain = "other-%s"
+	soa_email = "admin@%s"
+}
+
+resource "linode_domain" "clone" {
+	domain = "%s"
+	description = "vanity"
+	clone_from_domain_id = "${linode_domain.%s.id}"
+	depends_on = ["linode_domain_record.foobar"]
+}`, template, template, clone, source)
+}
@@ -0,0 +1,178 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLinodeDomainZone() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLinodeDomainZoneCreate,
+		Read:          resourceLinodeDomainZoneRead,
+		Update:        resourceLinodeDomainZoneUpdate,
+		Delete:        resourceLinodeDomainZoneDelete,
+		CustomizeDiff: resourceLinodeDomainZoneCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"domain_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Domain whose records are managed.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"zone_file": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The contents of a BIND zone file for the Domain. Its records replace all of the records of the Domain that are not excluded.",
+				Required:    true,
+			},
+			"exclude_types": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Record types, e.g. NS, that are left untouched and not managed by this resource.",
+				Optional:    true,
+			},
+			"exclude_names": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "Record names that are left untouched and not managed by this resource.",
+				Optional:    true,
+			},
+			"record": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The records of the Domain managed by this resource.",
+				Computed:    true,
+				Set:         domainRecordHash,
+				Elem: &schema.Resource{
+					Schema: domainRecordComputedSchema(),
+				},
+			},
+		},
+	}
+}
+
+// domainRecordComputedSchema returns the attributes of a record that is not configured directly
+func domainRecordComputedSchema() map[string]*schema.Schema {
+	s := domainRecordSetSchema()
+	for _, field := range s {
+		field.Required = false
+		field.Optional = false
+		field.Computed = true
+		field.ValidateFunc = nil
+	}
+	return s
+}
+
+// domainZoneRecords parses the zone file of the Domain into the records it describes, leaving out excluded records
+func domainZoneRecords(client *linodego.Client, domainID int, zoneFile string, exclusions domainRecordExclusions) ([]*linodego.DomainRecord, error) {
+	domain, err := client.GetDomain(context.TODO(), domainID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get Linode Domain %d because %s", domainID, err)
+	}
+
+	records, warnings, err := parseZoneFile(zoneFile, domain.Domain)
+	for _, warning := range warnings {
+		log.Printf("[WARN] Zone file of Linode Domain %s: %s", domain.Domain, warning)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return exclusions.managed(records), nil
+}
+
+// resourceLinodeDomainZoneCustomizeDiff plans the records parsed from the zone file, reporting problems
+// with the zone file before it is applied
+func resourceLinodeDomainZoneCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("domain_id") || !d.NewValueKnown("zone_file") || !d.NewValueKnown("exclude_types") || !d.NewValueKnown("exclude_names") {
+		return d.SetNewComputed("record")
+	}
+
+	client := meta.(linodego.Client)
+	records, err := domainZoneRecords(&client, d.Get("domain_id").(int), d.Get("zone_file").(string), expandDomainRecordExclusions(d.Get))
+	if err != nil {
+		return err
+	}
+
+	result := make([]interface{}, 0, len(records))
+	for _, record := range records {
+		result = append(result, flattenDomainRecord(record))
+	}
+	return d.SetNew("record", result)
+}
+
+func resourceLinodeDomainZoneRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID, err := strconv.Atoi(d.Id())
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Domain ID %s as int because %s", d.Id(), err)
+	}
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Linode Domain %d no longer exists", domainID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+
+	var result []map[string]interface{}
+	for _, record := range expandDomainRecordExclusions(d.Get).managed(records) {
+		result = append(result, flattenDomainRecord(record))
+	}
+	d.Set("record", result)
+
+	return nil
+}
+
+func resourceLinodeDomainZoneCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(fmt.Sprintf("%d", d.Get("domain_id").(int)))
+	return resourceLinodeDomainZoneUpdate(d, meta)
+}
+
+func resourceLinodeDomainZoneUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+	exclusions := expandDomainRecordExclusions(d.Get)
+
+	desired, err := domainZoneRecords(&client, domainID, d.Get("zone_file").(string), exclusions)
+	if err != nil {
+		return err
+	}
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+	if err := syncDomainRecords(&client, domainID, exclusions.managed(records), desired); err != nil {
+		return err
+	}
+
+	return resourceLinodeDomainZoneRead(d, meta)
+}
+
+func resourceLinodeDomainZoneDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	domainID := d.Get("domain_id").(int)
+
+	records, err := client.ListDomainRecords(context.TODO(), domainID, nil)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to list the records of Linode Domain %d because %s", domainID, err)
+	}
+	if err := syncDomainRecords(&client, domainID, expandDomainRecordExclusions(d.Get).managed(records), nil); err != nil {
+		return err
+	}
+	d.SetId("")
+	return nil
+}
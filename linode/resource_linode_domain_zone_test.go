@@ -0,0 +1,61 @@
+package linode
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+)
+
+func TestAccLinodeDomainZoneBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_domain_zone.foobar"
+	var domainName = fmt.Sprintf("tf-test-%s.example", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeDomainDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainZoneConfig(domainName, `$TTL 1h
+@	IN	MX	10 mail
+www	IN	A	192.0.2.1
+mail	IN	CNAME	www
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "3"),
+					testAccCheckLinodeDomainRecordsCount(resName, 3),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainZoneConfig(domainName, `$TTL 1h
+www	IN	A	192.0.2.2
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "record.#", "1"),
+					testAccCheckLinodeDomainRecordsCount(resName, 1),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeDomainZoneConfig(domainName, `www	IN	PTR	host.example.net.
+`),
+				ExpectError: regexp.MustCompile("the PTR record type is not supported"),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeDomainZoneConfig(domain, zoneFile string) string {
+	return testAccCheckLinodeDomainConfigBasic(domain) + fmt.Sprintf(`
+
+resource "linode_domain_zone" "foobar" {
+	domain_id = "${linode_domain.foobar.id}"
+	exclude_types = ["NS"]
+	zone_file = <<EOF
+%sEOF
+}`, zoneFile)
+}
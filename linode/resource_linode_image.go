@@ -0,0 +1,258 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// ImageWaitTimeout is the default number of seconds to wait for a disk to be imagized
+const ImageWaitTimeout = 3600
+
+// imageEventSkew allows for the clock of the API being behind when matching the imagize event
+const imageEventSkew = 5 * time.Second
+
+func resourceLinodeImage() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLinodeImageCreate,
+		Read:          resourceLinodeImageRead,
+		Update:        resourceLinodeImageUpdate,
+		Delete:        resourceLinodeImageDelete,
+		Exists:        resourceLinodeImageExists,
+		CustomizeDiff: resourceLinodeImageCustomizeDiff,
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode instance that owns the disk to create the Image from.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"disk_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode instance disk to create the Image from.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A short description of the Image. Labels cannot contain special characters.",
+				Required:    true,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A detailed description of this Image.",
+				Optional:    true,
+			},
+			"rebake_on_disk_change": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Create a new Image when the source disk has been changed, e.g. resized or rebuilt, since the Image was created.",
+				Optional:    true,
+				Default:     false,
+			},
+			"disk_updated": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the source disk was last updated once the Image was created.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When this Image was created.",
+				Computed:    true,
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The name of the User who created this Image.",
+				Computed:    true,
+			},
+			"deprecated": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Whether or not this Image is deprecated. Will only be True for deprecated public Images.",
+				Computed:    true,
+			},
+			"is_public": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "True if the Image is public.",
+				Computed:    true,
+			},
+			"size": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The minimum size this Image needs to deploy. Size is in MB.",
+				Computed:    true,
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "How the Image was created. 'Manual' Images can be created at any time. 'Automatic' Images are created automatically from a deleted Linode.",
+				Computed:    true,
+			},
+			"vendor": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The upstream distribution vendor. Nil for private Images.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceLinodeImageExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(linodego.Client)
+
+	_, err := client.GetImage(context.TODO(), d.Id())
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Failed to get Linode Image %s because %s", d.Id(), err)
+	}
+	return true, nil
+}
+
+func syncImageResourceData(d *schema.ResourceData, image *linodego.Image) {
+	d.Set("label", image.Label)
+	d.Set("description", image.Description)
+	d.Set("created", formatInstanceTime(image.Created))
+	d.Set("created_by", image.CreatedBy)
+	d.Set("deprecated", image.Deprecated)
+	d.Set("is_public", image.IsPublic)
+	d.Set("size", image.Size)
+	d.Set("type", image.Type)
+	d.Set("vendor", image.Vendor)
+}
+
+func resourceLinodeImageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	image, err := client.GetImage(context.TODO(), d.Id())
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Linode Image %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to find the specified Linode Image because %s", err)
+	}
+
+	syncImageResourceData(d, image)
+
+	return nil
+}
+
+func resourceLinodeImageCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Image")
+	}
+	d.Partial(true)
+
+	linodeID := d.Get("linode_id").(int)
+	diskID := d.Get("disk_id").(int)
+
+	disk, err := client.GetInstanceDisk(context.TODO(), linodeID, diskID)
+	if err != nil {
+		return fmt.Errorf("Failed to get disk %d of Linode instance %d because %s", diskID, linodeID, err)
+	}
+
+	imagizeOpts := linodego.InstanceDiskImagizeOptions{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+	}
+
+	log.Printf("[INFO] Creating Linode Image %s from disk %d of Linode instance %d", imagizeOpts.Label, diskID, linodeID)
+	minStart := time.Now().UTC().Add(-imageEventSkew).Truncate(time.Second)
+	image, err := client.ImagizeInstanceDisk(context.TODO(), linodeID, diskID, imagizeOpts)
+	if err != nil {
+		return fmt.Errorf("Failed to create a Linode Image from disk %d of Linode instance %d because %s", diskID, linodeID, err)
+	}
+	d.SetId(image.ID)
+	d.Set("disk_updated", formatInstanceTime(&disk.Updated))
+	d.SetPartial("linode_id")
+	d.SetPartial("disk_id")
+	d.SetPartial("label")
+	d.SetPartial("description")
+	d.SetPartial("rebake_on_disk_change")
+	d.SetPartial("disk_updated")
+
+	if _, err := client.WaitForEventFinished(context.TODO(), linodeID, linodego.EntityLinode, linodego.ActionDiskImagize, minStart, ImageWaitTimeout); err != nil {
+		return fmt.Errorf("Failed while waiting for Linode Image %s to be created because %s", image.ID, err)
+	}
+
+	// Creating the Image updates the disk, so the disk is read again to not plan a rebake right away
+	if disk, err = client.GetInstanceDisk(context.TODO(), linodeID, diskID); err != nil {
+		return fmt.Errorf("Failed to get disk %d of Linode instance %d because %s", diskID, linodeID, err)
+	}
+	d.Set("disk_updated", formatInstanceTime(&disk.Updated))
+	d.SetPartial("disk_updated")
+
+	d.Partial(false)
+
+	return resourceLinodeImageRead(d, meta)
+}
+
+func resourceLinodeImageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	if d.HasChange("label") || d.HasChange("description") {
+		image, err := client.GetImage(context.TODO(), d.Id())
+		if err != nil {
+			return fmt.Errorf("Failed to fetch data about the current Linode Image because %s", err)
+		}
+
+		updateOpts := image.GetUpdateOptions()
+		updateOpts.Label = d.Get("label").(string)
+		description := d.Get("description").(string)
+		updateOpts.Description = &description
+
+		if image, err = client.UpdateImage(context.TODO(), d.Id(), updateOpts); err != nil {
+			return fmt.Errorf("Failed to update Linode Image %s because %s", d.Id(), err)
+		}
+		syncImageResourceData(d, image)
+	}
+
+	return resourceLinodeImageRead(d, meta)
+}
+
+func resourceLinodeImageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	if err := client.DeleteImage(context.TODO(), d.Id()); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Failed to delete Linode Image %s because %s", d.Id(), err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceLinodeImageCustomizeDiff plans a new Image when rebake_on_disk_change is set and the source
+// disk has been updated since the Image was created
+func resourceLinodeImageCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.Get("rebake_on_disk_change").(bool) || d.HasChange("linode_id") || d.HasChange("disk_id") {
+		return nil
+	}
+
+	client := meta.(linodego.Client)
+	linodeID := d.Get("linode_id").(int)
+	diskID := d.Get("disk_id").(int)
+
+	disk, err := client.GetInstanceDisk(context.TODO(), linodeID, diskID)
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Disk %d of Linode instance %d no longer exists, the Linode Image is kept", diskID, linodeID)
+			return nil
+		}
+		return fmt.Errorf("Failed to get disk %d of Linode instance %d because %s", diskID, linodeID, err)
+	}
+
+	if diskUpdated := formatInstanceTime(&disk.Updated); diskUpdated != d.Get("disk_updated").(string) {
+		log.Printf("[INFO] Disk %d of Linode instance %d was updated at %s, planning a new Linode Image", diskID, linodeID, diskUpdated)
+		if err := d.SetNew("disk_updated", diskUpdated); err != nil {
+			return err
+		}
+		return d.ForceNew("disk_updated")
+	}
+
+	return nil
+}
@@ -0,0 +1,153 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestAccLinodeImageBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_image.foobar"
+	var imageName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeImageConfigBasic(imageName, "golden image"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeImageExists,
+					resource.TestCheckResourceAttr(resName, "label", imageName),
+					resource.TestCheckResourceAttr(resName, "description", "golden image"),
+					resource.TestCheckResourceAttr(resName, "type", "manual"),
+					resource.TestCheckResourceAttr(resName, "is_public", "false"),
+					resource.TestCheckResourceAttrSet(resName, "size"),
+					resource.TestCheckResourceAttrSet(resName, "created_by"),
+					resource.TestCheckResourceAttrSet(resName, "disk_updated"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeImageConfigBasic(imageName+"_renamed", "golden image, renamed"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeImageExists,
+					resource.TestCheckResourceAttr(resName, "label", imageName+"_renamed"),
+					resource.TestCheckResourceAttr(resName, "description", "golden image, renamed"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeImageRebakeOnDiskChange(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_image.foobar"
+	var imageName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeImageDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeImageConfigRebake(imageName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeImageExists,
+					resource.TestCheckResourceAttr(resName, "rebake_on_disk_change", "true"),
+					resource.TestCheckResourceAttrSet(resName, "disk_updated"),
+				),
+			},
+			resource.TestStep{
+				Config:   testAccCheckLinodeImageConfigRebake(imageName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeImageExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(linodego.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_image" {
+			continue
+		}
+
+		if _, err := client.GetImage(context.Background(), rs.Primary.ID); err != nil {
+			return fmt.Errorf("Error retrieving state of Image %s: %s", rs.Primary.Attributes["label"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeImageDestroy(s *terraform.State) error {
+	client, ok := testAccProvider.Meta().(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Failed to get Linode client")
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_image" {
+			continue
+		}
+
+		_, err := client.GetImage(context.Background(), rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("Linode Image with id %s still exists", rs.Primary.ID)
+		}
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Failed to request Linode Image with id %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeImageConfigBasic(image string, description string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+}
+
+resource "linode_image" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	disk_id = "${linode_instance.foobar.devices.0.sda.0.disk_id}"
+	label = "%s"
+	description = "%s"
+}`, image, image, description)
+}
+
+func testAccCheckLinodeImageConfigRebake(image string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+}
+
+resource "linode_image" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	disk_id = "${linode_instance.foobar.devices.0.sda.0.disk_id}"
+	label = "%s"
+	rebake_on_disk_change = true
+}`, image, image)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"encoding/base64"
 	"fmt"
+	"log"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/chiefy/linodego"
 	"github.com/hashicorp/terraform/helper/schema"
@@ -29,21 +31,25 @@ func init() {
 
 func resourceLinodeInstance() *schema.Resource {
 	return &schema.Resource{
-		Create: resourceLinodeInstanceCreate,
-		Read:   resourceLinodeInstanceRead,
-		Update: resourceLinodeInstanceUpdate,
-		Delete: resourceLinodeInstanceDelete,
-		Exists: resourceLinodeInstanceExists,
+		Create:        resourceLinodeInstanceCreate,
+		Read:          resourceLinodeInstanceRead,
+		Update:        resourceLinodeInstanceUpdate,
+		Delete:        resourceLinodeInstanceDelete,
+		Exists:        resourceLinodeInstanceExists,
+		CustomizeDiff: resourceLinodeInstanceCustomizeDiff,
+		SchemaVersion: 1,
+		MigrateState:  resourceLinodeInstanceMigrateState,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceLinodeInstanceImport,
 		},
 		Schema: map[string]*schema.Schema{
 			"image": &schema.Schema{
-				Type:         schema.TypeString,
-				Description:  "The image to deploy to the disk.",
-				Optional:     true,
-				ForceNew:     true,
-				InputDefault: "linode/debian9",
+				Type:             schema.TypeString,
+				Description:      "The image to deploy to the disk.",
+				Optional:         true,
+				ForceNew:         true,
+				InputDefault:     "linode/debian9",
+				DiffSuppressFunc: writeOnlyDiffSuppressFunc,
 			},
 			"kernel": &schema.Schema{
 				Type:         schema.TypeString,
@@ -52,22 +58,12 @@ func resourceLinodeInstance() *schema.Resource {
 				InputDefault: "linode/grub2",
 				Computed:     true,
 			},
-			"name": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true, // @TODO seems a bug that Optional is required when Removed is set
-				Removed:  "See 'label'",
-			},
 			"label": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The label of the Linode instance.",
 				Optional:    true,
 				Computed:    true,
 			},
-			"group": &schema.Schema{
-				Type:     schema.TypeString,
-				Optional: true,
-				Removed:  "See 'tags'",
-			},
 			"tags": &schema.Schema{
 				Type:        schema.TypeList,
 				Elem:        &schema.Schema{Type: schema.TypeString},
@@ -81,11 +77,6 @@ func resourceLinodeInstance() *schema.Resource {
 				ForceNew:     true,
 				InputDefault: "us-east",
 			},
-			"size": &schema.Schema{
-				Type:     schema.TypeInt,
-				Optional: true, // @TODO seems a bug that Optional is required when Removed is set
-				Removed:  "See 'type'",
-			},
 			"type": &schema.Schema{
 				Type:        schema.TypeString,
 				Description: "The type of instance to be deployed, determining the price and size.",
@@ -97,24 +88,60 @@ func resourceLinodeInstance() *schema.Resource {
 				Description: "The status of the instance, indicating the current readiness state.",
 				Computed:    true,
 			},
-			"plan_storage": &schema.Schema{
-				Type: schema.TypeInt,
-				// Optional: true, // @TODO seems a bug that Optional is required when Removed is set
-				Computed: true,
-				Removed:  "See 'storage'",
+			"specs": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The resources available to the Linode instance, as given by its type.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"disk": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The amount of local storage (MB) available to the Linode instance.",
+							Computed:    true,
+						},
+						"memory": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The amount of memory (MB) available to the Linode instance.",
+							Computed:    true,
+						},
+						"vcpus": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The number of virtual CPUs available to the Linode instance.",
+							Computed:    true,
+						},
+						"transfer": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The monthly network transfer (GB) included with the Linode instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"hypervisor": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The virtualization software powering the Linode instance.",
+				Computed:    true,
+			},
+			"image_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The image the Linode instance was last deployed from.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Linode instance was created.",
+				Computed:    true,
+			},
+			"updated": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the Linode instance was last updated.",
+				Computed:    true,
 			},
 			"storage": &schema.Schema{
-				Type: schema.TypeInt,
-				// Optional:    true, // @TODO seems a bug that Optional is required when Removed is set
+				Type:        schema.TypeInt,
 				Computed:    true,
 				Description: "The total amount of local disk space (MB) available to this Linode instance.",
 			},
-			"plan_storage_utilized": &schema.Schema{
-				Type:     schema.TypeInt,
-				Computed: true,
-				// Optional: true, // @TODO seems a bug that Optional is required when Removed is set
-				Removed: "See 'storage_utilized'",
-			},
 			"storage_utilized": &schema.Schema{
 				Type:        schema.TypeInt,
 				Description: "The total amount of local disk space (MB) utilized by this Linode instance.",
@@ -132,21 +159,79 @@ func resourceLinodeInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"ipv4": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The public, private and shared IPv4 addresses of the Linode instance.",
+				Computed:    true,
+			},
+			"ipv6": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The SLAAC and link-local IPv6 addresses and the global IPv6 ranges of the Linode instance.",
+				Computed:    true,
+			},
+			"ip_addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The details of every IPv4 and IPv6 address assigned to the Linode instance.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"public": &schema.Schema{
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"prefix": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"gateway": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"subnet_mask": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"rdns": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"connection_ip_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The address used by provisioners to connect to the Linode instance. (public, private, ipv6)",
+				Optional:     true,
+				Default:      "public",
+				ValidateFunc: validateConnectionIPType,
+			},
 			"ssh_key": &schema.Schema{
-				Type:          schema.TypeList,
-				Elem:          &schema.Schema{Type: schema.TypeString},
-				Description:   "The public keys to be used for accessing the root account via ssh.",
-				Optional:      true,
-				ForceNew:      true,
-				StateFunc:     sshKeyState,
-				PromoteSingle: true,
+				Type:             schema.TypeList,
+				Elem:             &schema.Schema{Type: schema.TypeString},
+				Description:      "The public keys to be used for accessing the root account via ssh.",
+				Optional:         true,
+				ForceNew:         true,
+				StateFunc:        sshKeyState,
+				PromoteSingle:    true,
+				DiffSuppressFunc: writeOnlyDiffSuppressFunc,
 			},
 			"root_password": &schema.Schema{
-				Type:        schema.TypeString,
-				Description: "The password that will be initialially assigned to the 'root' user account.",
-				Required:    true,
-				ForceNew:    true,
-				StateFunc:   rootPasswordState,
+				Type:             schema.TypeString,
+				Description:      "The password that will be initialially assigned to the 'root' user account.",
+				Required:         true,
+				ForceNew:         true,
+				StateFunc:        rootPasswordState,
+				DiffSuppressFunc: writeOnlyDiffSuppressFunc,
 			},
 			"helper_distro": &schema.Schema{
 				Type:        schema.TypeBool,
@@ -154,23 +239,49 @@ func resourceLinodeInstance() *schema.Resource {
 				Optional:    true,
 				Default:     true,
 			},
-			"manage_private_ip_automatically": &schema.Schema{
-				Type:     schema.TypeBool,
-				Optional: true, // @TODO seems a bug that Optional is required when Removed is set
-				Removed:  "See 'helper_network'",
-			},
 			"helper_network": &schema.Schema{
 				Type:        schema.TypeBool,
 				Description: "Controls the behavior of the Linode Config's Network Helper setting, used to automatically configure additional IP addresses assigned to this instance.",
 				Optional:    true,
 				Default:     true,
 			},
+			"watchdog_enabled": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "Controls the Linode instance's Lassie shutdown watchdog, which reboots the instance if it powers off unexpectedly.",
+				Optional:    true,
+				Default:     true,
+			},
 			"disk_expansion": &schema.Schema{
 				Type:        schema.TypeBool,
 				Description: "Controls the Linode Terraform provider's behavior of resizing the disk to full size after resizing to a larger Linode type.",
 				Optional:    true,
 				Default:     false,
 			},
+			"root_device": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The device the Linode Config boots from, e.g. /dev/sda. Use the device of a volume slot to boot from a Block Storage volume.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"devices": &schema.Schema{
+				Type:             schema.TypeList,
+				Description:      "The disks and Block Storage volumes mapped into the sda-sdh device slots of the Linode Config.",
+				Optional:         true,
+				MaxItems:         1,
+				DiffSuppressFunc: instanceConfigDeviceDiffSuppressFunc,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"sda": instanceConfigDeviceSchema(),
+						"sdb": instanceConfigDeviceSchema(),
+						"sdc": instanceConfigDeviceSchema(),
+						"sdd": instanceConfigDeviceSchema(),
+						"sde": instanceConfigDeviceSchema(),
+						"sdf": instanceConfigDeviceSchema(),
+						"sdg": instanceConfigDeviceSchema(),
+						"sdh": instanceConfigDeviceSchema(),
+					},
+				},
+			},
 			"swap_size": &schema.Schema{
 				Type:        schema.TypeInt,
 				Description: "Storage (MB) to dedicate to local swap disk (memory) space.",
@@ -181,6 +292,34 @@ func resourceLinodeInstance() *schema.Resource {
 	}
 }
 
+// instanceConfigDeviceSlots are the device slots of a Linode Config, in order
+var instanceConfigDeviceSlots = []string{"sda", "sdb", "sdc", "sdd", "sde", "sdf", "sdg", "sdh"}
+
+func instanceConfigDeviceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:             schema.TypeList,
+		Description:      "The disk or Block Storage volume mapped into this device slot.",
+		Optional:         true,
+		MaxItems:         1,
+		DiffSuppressFunc: instanceConfigDeviceDiffSuppressFunc,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"disk_id": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "The ID of the disk in this slot.",
+					Optional:    true,
+					Computed:    true,
+				},
+				"volume_id": &schema.Schema{
+					Type:        schema.TypeInt,
+					Description: "The ID of the Block Storage volume in this slot.",
+					Optional:    true,
+				},
+			},
+		},
+	}
+}
+
 func resourceLinodeInstanceExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(linodego.Client)
 	id, err := strconv.ParseInt(d.Id(), 10, 64)
@@ -228,11 +367,6 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 
 	if len(public) > 0 {
 		d.Set("ip_address", public[0].Address)
-
-		d.SetConnInfo(map[string]string{
-			"type": "ssh",
-			"host": public[0].Address,
-		})
 	}
 
 	if len(private) > 0 {
@@ -240,6 +374,19 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		d.Set("private_ip_address", private[0].Address)
 	} else {
 		d.Set("private_networking", false)
+		d.Set("private_ip_address", "")
+	}
+
+	ipv4, ipv6, ipAddresses := flattenInstanceIPAddresses(instanceNetwork)
+	d.Set("ipv4", ipv4)
+	d.Set("ipv6", ipv6)
+	d.Set("ip_addresses", ipAddresses)
+
+	if host := connectionHost(instanceNetwork, d.Get("connection_ip_type").(string)); host != "" {
+		d.SetConnInfo(map[string]string{
+			"type": "ssh",
+			"host": host,
+		})
 	}
 
 	d.Set("label", instance.Label)
@@ -247,11 +394,16 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("type", instance.Type)
 	d.Set("region", instance.Region)
 
-	d.Set("group", instance.Group)
-
-	planStorage := instance.Specs.Disk
-	d.Set("plan_storage", planStorage)
-	d.Set("storage", planStorage)
+	d.Set("tags", instance.Tags)
+	d.Set("hypervisor", instance.Hypervisor)
+	d.Set("watchdog_enabled", instance.WatchdogEnabled)
+	d.Set("image_id", instance.Image)
+	d.Set("created", formatInstanceTime(instance.Created))
+	d.Set("updated", formatInstanceTime(instance.Updated))
+	d.Set("specs", flattenInstanceSpecs(instance.Specs))
+	if instance.Specs != nil {
+		d.Set("storage", instance.Specs.Disk)
+	}
 
 	instanceDisks, err := client.ListInstanceDisks(context.TODO(), int(id), nil)
 
@@ -259,20 +411,19 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("Failed to get the disks for the Linode instance %d because %s", id, err)
 	}
 
-	planStorageUtilized := 0
+	storageUtilized := 0
 	swapSize := 0
 
 	for _, disk := range instanceDisks {
-		planStorageUtilized += disk.Size
+		storageUtilized += disk.Size
 		// Determine if swap exists and the size.  If it does not exist, swap_size=0
 		if disk.Filesystem == "swap" {
 			swapSize = disk.Size
-			d.Set("swap_size", swapSize)
 		}
 	}
+	d.Set("swap_size", swapSize)
 
-	d.Set("plan_storage_utilized", planStorageUtilized)
-	d.Set("storage_utilized", planStorageUtilized)
+	d.Set("storage_utilized", storageUtilized)
 
 	//diskExpansion := d.Get("disk_expansion").(bool)
 	//d.Set("disk_expansion", diskExpansion)
@@ -300,10 +451,83 @@ func resourceLinodeInstanceRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("helper_distro", boolToString(config.Helpers.Distro))
 	d.Set("helper_network", boolToString(config.Helpers.Network))
 	d.Set("kernel", config.Kernel)
+	d.Set("root_device", config.RootDevice)
+	d.Set("devices", flattenInstanceConfigDevices(config.Devices))
 
 	return nil
 }
 
+// resourceLinodeInstanceImport reconstructs the attributes of an existing Linode instance that can not be
+// refreshed by Read alone. Write-only attributes (root_password, ssh_key) are left empty and are
+// ignored by writeOnlyDiffSuppressFunc so that the first plan after import does not replace the instance.
+func resourceLinodeInstanceImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse Linode instance ID %s as int because %s", d.Id(), err)
+	}
+
+	instance, err := client.GetInstance(context.TODO(), int(id))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to find the specified Linode instance because %s", err)
+	}
+
+	configs, err := client.ListInstanceConfigs(context.TODO(), instance.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the config for Linode instance %d because %s", instance.ID, err)
+	} else if len(configs) != 1 {
+		return nil, fmt.Errorf("Linode instance %d has %d configs, only instances with exactly 1 config can be imported", instance.ID, len(configs))
+	}
+	config := configs[0]
+
+	disks, err := client.ListInstanceDisks(context.TODO(), instance.ID, nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the disks for Linode instance %d because %s", instance.ID, err)
+	}
+
+	diskIDs := make(map[int]bool, len(disks))
+	for _, disk := range disks {
+		diskIDs[disk.ID] = true
+	}
+
+	// The config boots from a disk of the instance, or from a volume given as the root_device
+	bootsFromDisk := config.Devices != nil && config.Devices.SDA != nil && diskIDs[config.Devices.SDA.DiskID]
+	bootsFromVolume := false
+	if config.Devices != nil {
+		for _, slot := range instanceConfigDeviceSlots {
+			if device := *instanceConfigDeviceSlot(config.Devices, slot); device != nil && device.VolumeID != 0 && config.RootDevice == "/dev/"+slot {
+				bootsFromVolume = true
+			}
+		}
+	}
+	if !bootsFromDisk && !bootsFromVolume {
+		return nil, fmt.Errorf("Linode instance %d config %d does not boot from a disk of the instance or a volume", instance.ID, config.ID)
+	}
+
+	d.Set("image", instance.Image)
+	d.Set("disk_expansion", false)
+	d.Set("connection_ip_type", "public")
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// writeOnlyDiffSuppressFunc suppresses diffs on attributes that the API never returns, when the
+// existing resource was imported and has no recorded value for them.  These attributes only apply
+// when the instance disks are created, so there is nothing to compare against.  An imported instance
+// is recognized by its empty root_password, which is required and recorded for every created instance.
+func writeOnlyDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	if rootPassword, _ := d.GetChange("root_password"); rootPassword.(string) != "" {
+		return false
+	}
+	if strings.HasSuffix(k, ".#") {
+		return old == "" || old == "0"
+	}
+	return old == ""
+}
+
 func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) error {
 	waitSeconds := 180
 	client, ok := meta.(linodego.Client)
@@ -331,7 +555,7 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		Region: d.Get("region").(string),
 		Type:   d.Get("type").(string),
 		Label:  d.Get("label").(string),
-		Group:  d.Get("group").(string),
+		Tags:   expandStringList(d.Get("tags").([]interface{})),
 	}
 	instance, err := client.CreateInstance(context.TODO(), &createOpts)
 	if err != nil {
@@ -343,7 +567,15 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 	d.SetPartial("region")
 	d.SetPartial("type")
 	d.SetPartial("label")
-	d.SetPartial("group")
+	d.SetPartial("tags")
+
+	// New instances always start with the watchdog enabled
+	if watchdogEnabled := d.Get("watchdog_enabled").(bool); !watchdogEnabled {
+		if _, err := client.UpdateInstance(context.TODO(), instance.ID, &linodego.InstanceUpdateOptions{WatchdogEnabled: &watchdogEnabled}); err != nil {
+			return fmt.Errorf("Failed to disable the watchdog of Linode instance %d because %s", instance.ID, err)
+		}
+	}
+	d.SetPartial("watchdog_enabled")
 
 	swapSize := 0
 	var swapDisk *linodego.InstanceDisk
@@ -434,14 +666,21 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 		SDA: &linodego.InstanceConfigDevice{DiskID: storageDisk.ID},
 	}
 
-	if swapDisk.ID > 0 {
+	if swapDisk != nil && swapDisk.ID > 0 {
 		configDevices.SDB = &linodego.InstanceConfigDevice{DiskID: swapDisk.ID}
 	}
 
+	// Volumes and disks given in the devices slots take the place of the created disks
+	for _, slot := range instanceConfigDeviceSlots {
+		if device := expandInstanceConfigDevice(d, slot); device != nil {
+			*instanceConfigDeviceSlot(configDevices, slot) = device
+		}
+	}
+
 	configOpts := linodego.InstanceConfigCreateOptions{
-		Label:  fmt.Sprintf("linode%d-config", instance.ID),
-		Kernel: d.Get("kernel").(string),
-		// RootDevice: "/dev/sda",
+		Label:      fmt.Sprintf("linode%d-config", instance.ID),
+		Kernel:     d.Get("kernel").(string),
+		RootDevice: d.Get("root_device").(string),
 		// RunLevel:   "default",
 		// VirtMode:   "paravirt",
 		Helpers: &linodego.InstanceConfigHelpers{
@@ -458,6 +697,8 @@ func resourceLinodeInstanceCreate(d *schema.ResourceData, meta interface{}) erro
 
 	d.SetPartial("helper_network")
 	d.SetPartial("helper_distro")
+	d.SetPartial("root_device")
+	d.SetPartial("devices")
 
 	booted, err := client.BootInstance(context.TODO(), instance.ID, config.ID)
 	if !booted {
@@ -494,6 +735,24 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("label")
 	}
 
+	if d.HasChange("tags") {
+		tags := expandStringList(d.Get("tags").([]interface{}))
+		if instance, err = client.UpdateInstance(context.TODO(), instance.ID, &linodego.InstanceUpdateOptions{Tags: &tags}); err != nil {
+			return fmt.Errorf("Failed to update the tags of Linode instance %d because %s", id, err)
+		}
+		d.Set("tags", instance.Tags)
+		d.SetPartial("tags")
+	}
+
+	if d.HasChange("watchdog_enabled") {
+		watchdogEnabled := d.Get("watchdog_enabled").(bool)
+		if instance, err = client.UpdateInstance(context.TODO(), int(id), &linodego.InstanceUpdateOptions{WatchdogEnabled: &watchdogEnabled}); err != nil {
+			return fmt.Errorf("Failed to update the watchdog of Linode instance %d because %s", id, err)
+		}
+		d.Set("watchdog_enabled", instance.WatchdogEnabled)
+		d.SetPartial("watchdog_enabled")
+	}
+
 	rebootInstance := false
 
 	if d.HasChange("type") {
@@ -504,19 +763,24 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("private_networking") {
-		if !d.Get("private_networking").(bool) {
-			return fmt.Errorf("Can't deactivate private networking for linode %s", d.Id())
-		}
-
-		resp, err := client.AddInstanceIPAddress(context.TODO(), int(id), false)
-
-		if err != nil {
-			return fmt.Errorf("Failed to activate private networking on linode %s because %s", d.Id(), err)
+		if d.Get("private_networking").(bool) {
+			resp, err := client.AddInstanceIPAddress(context.TODO(), int(id), false)
+			if err != nil {
+				return fmt.Errorf("Failed to activate private networking on linode %s because %s", d.Id(), err)
+			}
+			d.Set("private_ip_address", resp.Address)
+			rebootInstance = true
+		} else {
+			if err := removeLinodePrivateNetworking(&client, instance); err != nil {
+				return err
+			}
+			d.Set("private_ip_address", "")
+			// Without the Network Helper the guest's network configuration is not rewritten on boot,
+			// so there is nothing a reboot would change
+			rebootInstance = rebootInstance || d.Get("helper_network").(bool)
 		}
 		d.SetPartial("private_networking")
-		d.Set("private_ip_address", resp.Address)
 		d.SetPartial("private_ip_address")
-		rebootInstance = true
 	}
 
 	configs, err := client.ListInstanceConfigs(context.TODO(), int(id), nil)
@@ -540,6 +804,26 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		updateConfig = true
 		config.Kernel = d.Get("kernel").(string)
 	}
+	if d.HasChange("root_device") {
+		updateConfig = true
+		config.RootDevice = d.Get("root_device").(string)
+	}
+	if d.HasChange("devices") {
+		updateConfig = true
+		// Every slot is sent, so emptied slots are cleared rather than kept by the API. Disks stay in the slots
+		// left out of the devices block, volumes are detached from them.
+		current := config.Devices
+		config.Devices = &linodego.InstanceConfigDeviceMap{}
+		for _, slot := range instanceConfigDeviceSlots {
+			device := expandInstanceConfigDevice(d, slot)
+			if device == nil && current != nil && len(d.Get(fmt.Sprintf("devices.0.%s", slot)).([]interface{})) == 0 {
+				if found := *instanceConfigDeviceSlot(current, slot); found != nil && found.VolumeID == 0 {
+					device = found
+				}
+			}
+			*instanceConfigDeviceSlot(config.Devices, slot) = device
+		}
+	}
 
 	if updateConfig {
 		_, err := client.UpdateInstanceConfig(context.TODO(), instance.ID, configs[0].ID, config)
@@ -549,6 +833,8 @@ func resourceLinodeInstanceUpdate(d *schema.ResourceData, meta interface{}) erro
 		d.SetPartial("helper_distro")
 		d.SetPartial("helper_network")
 		d.SetPartial("kernel")
+		d.SetPartial("root_device")
+		d.SetPartial("devices")
 
 		rebootInstance = true
 	}
@@ -581,6 +867,167 @@ func resourceLinodeInstanceDelete(d *schema.ResourceData, meta interface{}) erro
 	return nil
 }
 
+// removeLinodePrivateNetworking deletes the private IPv4 addresses of a Linode instance. Addresses that
+// are still used by NodeBalancer nodes are not removed, since the nodes would stop receiving traffic.
+func removeLinodePrivateNetworking(client *linodego.Client, instance *linodego.Instance) error {
+	network, err := client.GetInstanceIPAddresses(context.TODO(), instance.ID)
+	if err != nil {
+		return fmt.Errorf("Failed to get the IP addresses of Linode instance %d because %s", instance.ID, err)
+	}
+	if network.IPv4 == nil || len(network.IPv4.Private) == 0 {
+		return nil
+	}
+
+	nodes, err := listNodeBalancerNodes(client)
+	if err != nil {
+		return err
+	}
+
+	for _, ip := range network.IPv4.Private {
+		if dependents := nodeBalancerNodesUsingAddress(nodes, ip.Address); len(dependents) > 0 {
+			labels := make([]string, 0, len(dependents))
+			for _, node := range dependents {
+				labels = append(labels, fmt.Sprintf("%s (NodeBalancer %d, config %d)", node.Label, node.NodeBalancerID, node.ConfigID))
+			}
+			return fmt.Errorf("Can't deactivate private networking for Linode instance %d because private IP address %s is used by NodeBalancer nodes %s. Remove or re-address these nodes first",
+				instance.ID, ip.Address, strings.Join(labels, ", "))
+		}
+	}
+
+	for _, ip := range network.IPv4.Private {
+		log.Printf("[INFO] Removing private IP address %s from Linode instance %d", ip.Address, instance.ID)
+		if err := client.DeleteInstanceIPAddress(context.TODO(), instance.ID, ip.Address); err != nil {
+			return fmt.Errorf("Failed to remove private IP address %s from Linode instance %d because %s", ip.Address, instance.ID, err)
+		}
+	}
+	return nil
+}
+
+// listNodeBalancerNodes returns the nodes of every NodeBalancer config on the account
+func listNodeBalancerNodes(client *linodego.Client) ([]*linodego.NodeBalancerNode, error) {
+	nodebalancers, err := client.ListNodeBalancers(context.TODO(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list NodeBalancers because %s", err)
+	}
+
+	var nodes []*linodego.NodeBalancerNode
+	for _, nodebalancer := range nodebalancers {
+		configs, err := client.ListNodeBalancerConfigs(context.TODO(), nodebalancer.ID, nil)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to list the configs of NodeBalancer %d because %s", nodebalancer.ID, err)
+		}
+		for _, config := range configs {
+			configNodes, err := client.ListNodeBalancerNodes(context.TODO(), nodebalancer.ID, config.ID, nil)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to list the nodes of NodeBalancer %d config %d because %s", nodebalancer.ID, config.ID, err)
+			}
+			nodes = append(nodes, configNodes...)
+		}
+	}
+	return nodes, nil
+}
+
+// nodeBalancerNodesUsingAddress returns the nodes whose "address:port" uses the given IP address
+func nodeBalancerNodesUsingAddress(nodes []*linodego.NodeBalancerNode, address string) []*linodego.NodeBalancerNode {
+	var result []*linodego.NodeBalancerNode
+	for _, node := range nodes {
+		host := node.Address
+		if i := strings.LastIndex(host, ":"); i >= 0 {
+			host = host[:i]
+		}
+		if host == address {
+			result = append(result, node)
+		}
+	}
+	return result
+}
+
+// instanceConfigDeviceSlot returns the field of the device map that holds the named slot
+func instanceConfigDeviceSlot(devices *linodego.InstanceConfigDeviceMap, slot string) **linodego.InstanceConfigDevice {
+	switch slot {
+	case "sda":
+		return &devices.SDA
+	case "sdb":
+		return &devices.SDB
+	case "sdc":
+		return &devices.SDC
+	case "sdd":
+		return &devices.SDD
+	case "sde":
+		return &devices.SDE
+	case "sdf":
+		return &devices.SDF
+	case "sdg":
+		return &devices.SDG
+	case "sdh":
+		return &devices.SDH
+	}
+	panic(fmt.Sprintf("Unknown Linode Config device slot %s", slot))
+}
+
+// instanceConfigDeviceDiffSuppressFunc keeps the disks found in device slots that are left out of the devices
+// block, such as the root and swap disks. Only volumes are detached from slots that are no longer given.
+// Unset blocks read back from the state while planning, so a slot left out of the config is recognized by
+// its new value in the diff: an empty attribute, or a count of zero.
+func instanceConfigDeviceDiffSuppressFunc(k, old, new string, d *schema.ResourceData) bool {
+	parts := strings.Split(k, ".")
+	if len(parts) < 4 {
+		if new != "" && new != "0" {
+			return false
+		}
+		for _, slot := range instanceConfigDeviceSlots {
+			if instanceConfigDeviceHeldVolume(d, slot) {
+				return false
+			}
+		}
+		return true
+	}
+
+	if instanceConfigDeviceHeldVolume(d, parts[2]) {
+		return false
+	}
+	return new == "" || (parts[3] == "#" && new == "0")
+}
+
+// instanceConfigDeviceHeldVolume reports whether a device slot held a volume before this change
+func instanceConfigDeviceHeldVolume(d *schema.ResourceData, slot string) bool {
+	volumeID, _ := d.GetChange(fmt.Sprintf("devices.0.%s.0.volume_id", slot))
+	return volumeID.(int) != 0
+}
+
+// expandInstanceConfigDevice returns the volume or disk configured for a device slot, or nil when the slot is empty.
+// A volume takes precedence over a disk.
+func expandInstanceConfigDevice(d *schema.ResourceData, slot string) *linodego.InstanceConfigDevice {
+	key := fmt.Sprintf("devices.0.%s.0", slot)
+	if volumeID, ok := d.GetOk(key + ".volume_id"); ok {
+		return &linodego.InstanceConfigDevice{VolumeID: volumeID.(int)}
+	}
+	if diskID, ok := d.GetOk(key + ".disk_id"); ok {
+		return &linodego.InstanceConfigDevice{DiskID: diskID.(int)}
+	}
+	return nil
+}
+
+// flattenInstanceConfigDevices converts the device map of a Linode Config into the devices attribute
+func flattenInstanceConfigDevices(devices *linodego.InstanceConfigDeviceMap) []map[string]interface{} {
+	if devices == nil {
+		return nil
+	}
+
+	result := make(map[string]interface{})
+	for _, slot := range instanceConfigDeviceSlots {
+		device := *instanceConfigDeviceSlot(devices, slot)
+		if device == nil || (device.DiskID == 0 && device.VolumeID == 0) {
+			continue
+		}
+		result[slot] = []map[string]interface{}{{
+			"disk_id":   device.DiskID,
+			"volume_id": device.VolumeID,
+		}}
+	}
+	return []map[string]interface{}{result}
+}
+
 // getKernel gets the kernel from the id of the kernel
 func getKernel(client *linodego.Client, kernelID string) (*linodego.LinodeKernel, error) {
 	if kernelList == nil {
@@ -711,6 +1158,80 @@ func getBiggestDisk(client *linodego.Client, linodeID int) (biggestDiskID int, b
 	return biggestDiskID, biggestDiskSize, nil
 }
 
+// flattenInstanceIPAddresses returns the IPv4 addresses, the IPv6 addresses and ranges, and the
+// details of every address in the instance network
+func flattenInstanceIPAddresses(network *linodego.InstanceIPAddressResponse) (ipv4 []string, ipv6 []string, ipAddresses []map[string]interface{}) {
+	ipv4, ipv6, ipAddresses = []string{}, []string{}, []map[string]interface{}{}
+
+	addIP := func(ip *linodego.InstanceIP) {
+		if ip == nil || ip.Address == "" {
+			return
+		}
+		ipAddresses = append(ipAddresses, map[string]interface{}{
+			"address":     ip.Address,
+			"type":        ip.Type,
+			"public":      ip.Public,
+			"prefix":      ip.Prefix,
+			"gateway":     ip.Gateway,
+			"subnet_mask": ip.SubnetMask,
+			"rdns":        ip.RDNS,
+		})
+	}
+
+	if network.IPv4 != nil {
+		for _, group := range [][]*linodego.InstanceIP{network.IPv4.Public, network.IPv4.Private, network.IPv4.Shared} {
+			for _, ip := range group {
+				ipv4 = append(ipv4, ip.Address)
+				addIP(ip)
+			}
+		}
+	}
+
+	if network.IPv6 != nil {
+		for _, ip := range []*linodego.InstanceIP{network.IPv6.SLAAC, network.IPv6.LinkLocal} {
+			if ip != nil && ip.Address != "" {
+				ipv6 = append(ipv6, ip.Address)
+			}
+			addIP(ip)
+		}
+		for _, r := range network.IPv6.Global {
+			ipv6 = append(ipv6, r.Range)
+		}
+	}
+
+	return ipv4, ipv6, ipAddresses
+}
+
+// connectionHost picks the address provisioners should use for the requested connection_ip_type,
+// falling back to the first public IPv4 address when the requested type is not available
+func connectionHost(network *linodego.InstanceIPAddressResponse, ipType string) string {
+	switch ipType {
+	case "private":
+		if network.IPv4 != nil && len(network.IPv4.Private) > 0 {
+			return network.IPv4.Private[0].Address
+		}
+	case "ipv6":
+		if network.IPv6 != nil && network.IPv6.SLAAC != nil && network.IPv6.SLAAC.Address != "" {
+			return network.IPv6.SLAAC.Address
+		}
+	}
+
+	if network.IPv4 != nil && len(network.IPv4.Public) > 0 {
+		return network.IPv4.Public[0].Address
+	}
+	return ""
+}
+
+// validateConnectionIPType ensures connection_ip_type is one of the supported address types
+func validateConnectionIPType(v interface{}, k string) (ws []string, errors []error) {
+	switch v.(string) {
+	case "public", "private", "ipv6":
+	default:
+		errors = append(errors, fmt.Errorf("%q must be one of public, private or ipv6, got %q", k, v))
+	}
+	return
+}
+
 // sshKeyState hashes a string passed in as an interface
 func sshKeyState(val interface{}) string {
 	return hashString(strings.Join(val.([]string), "\n"))
@@ -727,7 +1248,118 @@ func hashString(key string) string {
 	return base64.StdEncoding.EncodeToString(hash[:])
 }
 
-// changeLinodeSize resizes the current linode
+// instanceResizePlan describes the disk change needed to move a Linode instance to a new type.
+// Only the largest disk is ever resized, other disks keep their size.
+type instanceResizePlan struct {
+	diskID      int
+	diskSize    int
+	newDiskSize int
+}
+
+// shrink reports whether the disk must be shrunk before the instance is resized
+func (p instanceResizePlan) shrink() bool {
+	return p.newDiskSize < p.diskSize
+}
+
+// grow reports whether the disk should be grown after the instance is resized
+func (p instanceResizePlan) grow() bool {
+	return p.newDiskSize > p.diskSize
+}
+
+// planInstanceResize computes the size of the largest disk of an instance on the target type.
+// When the disks do not fit the target type, the largest disk is shrunk to fill the remaining space.
+// When the target type is larger and expand is set, the largest disk is grown to fill the free space.
+// An error is returned when the other disks alone do not leave room for the largest disk.
+func planInstanceResize(currentType, targetType *linodego.LinodeType, disks []*linodego.InstanceDisk, expand bool) (plan instanceResizePlan, err error) {
+	used := 0
+	for _, disk := range disks {
+		used += disk.Size
+		if disk.Size > plan.diskSize {
+			plan.diskID = disk.ID
+			plan.diskSize = disk.Size
+		}
+	}
+	plan.newDiskSize = plan.diskSize
+
+	free := targetType.Disk - (used - plan.diskSize)
+
+	if used > targetType.Disk {
+		if plan.diskID == 0 || free <= 0 {
+			return plan, fmt.Errorf("The disks of this Linode instance use %d MB and can not be shrunk to fit the %d MB of type %s", used, targetType.Disk, targetType.ID)
+		}
+		plan.newDiskSize = free
+	} else if expand && plan.diskID != 0 && targetType.Disk > currentType.Disk {
+		plan.newDiskSize = free
+	}
+
+	return plan, nil
+}
+
+// resourceLinodeInstanceCustomizeDiff fails the plan when a type change can not fit the disks of the instance
+func resourceLinodeInstanceCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("type") {
+		return nil
+	}
+	// An interpolated type is only checked once it is known, when the resize is applied
+	if !d.NewValueKnown("type") {
+		return nil
+	}
+
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode instance ID %s as int because %s", d.Id(), err)
+	}
+
+	oldTypeID, newTypeID := d.GetChange("type")
+	currentType, err := getType(&client, oldTypeID.(string))
+	if err != nil {
+		return fmt.Errorf("Failed to find the instance type %s", oldTypeID)
+	}
+	targetType, err := getType(&client, newTypeID.(string))
+	if err != nil {
+		return fmt.Errorf("Failed to find the instance type %s", newTypeID)
+	}
+
+	disks, err := client.ListInstanceDisks(context.TODO(), int(id), nil)
+	if err != nil {
+		return fmt.Errorf("Failed to get the disks for the Linode instance %d because %s", id, err)
+	}
+
+	_, err = planInstanceResize(currentType, targetType, disks, d.Get("disk_expansion").(bool))
+	return err
+}
+
+// shutdownLinodeInstance powers off the instance and waits until it is offline
+func shutdownLinodeInstance(client *linodego.Client, instance *linodego.Instance) error {
+	if ok, err := client.ShutdownInstance(context.TODO(), instance.ID); err != nil || !ok {
+		return fmt.Errorf("Failed to shutdown Linode instance %d because %s", instance.ID, err)
+	}
+	if err := linodego.WaitForInstanceStatus(context.TODO(), client, instance.ID, linodego.InstanceOffline, WaitTimeout); err != nil {
+		return fmt.Errorf("Timed-out waiting for Linode instance %d to shutdown because %s", instance.ID, err)
+	}
+	return nil
+}
+
+// resizeLinodeDisk resizes a disk of an offline instance and waits for the resize to complete
+func resizeLinodeDisk(client *linodego.Client, instance *linodego.Instance, diskID int, size int, waitSeconds int) error {
+	log.Printf("[INFO] Resizing disk %d of Linode instance %d to %d MB", diskID, instance.ID, size)
+	// The resize responds without the disk, so the event is awaited from the time of the request, in
+	// whole seconds like the timestamps of events
+	minStart := time.Now().UTC().Truncate(time.Second)
+	if _, err := client.ResizeInstanceDisk(context.TODO(), instance.ID, diskID, size); err != nil {
+		return fmt.Errorf("Failed to resize Disk %d for Linode %d because %s", diskID, instance.ID, err)
+	}
+
+	_, err := client.WaitForEventFinished(context.TODO(), instance.ID, linodego.EntityLinode, linodego.ActionDiskResize, minStart, waitSeconds)
+	if err != nil {
+		return fmt.Errorf("Failed to wait for resize of Disk %d for Linode %d because %s", diskID, instance.ID, err)
+	}
+	return nil
+}
+
+// changeLinodeSize resizes the current linode, shrinking the largest disk first when downsizing and
+// growing it afterwards when upsizing with disk_expansion
 func changeLinodeSize(client *linodego.Client, instance *linodego.Instance, d *schema.ResourceData) error {
 	typeID, ok := d.Get("type").(string)
 	if !ok {
@@ -739,12 +1371,19 @@ func changeLinodeSize(client *linodego.Client, instance *linodego.Instance, d *s
 		return fmt.Errorf("Failed to find the instance type %s", typeID)
 	}
 
-	//biggestDiskID, biggestDiskSize, err := getBiggestDisk(client, instance.ID)
+	currentType, err := getType(client, instance.Type)
+	if err != nil {
+		return fmt.Errorf("Failed to find the instance type %s", instance.Type)
+	}
 
-	//currentDiskSize, err := getTotalDiskSize(client, instance.ID)
+	disks, err := client.ListInstanceDisks(context.TODO(), instance.ID, nil)
+	if err != nil {
+		return fmt.Errorf("Failed to get the disks for the Linode instance %d because %s", instance.ID, err)
+	}
 
-	if ok, err := client.ResizeInstance(context.TODO(), instance.ID, typeID); err != nil || !ok {
-		return fmt.Errorf("Failed resizing instance %d because %s", instance.ID, err)
+	plan, err := planInstanceResize(currentType, targetType, disks, d.Get("disk_expansion").(bool))
+	if err != nil {
+		return err
 	}
 
 	// Linode says 1-3 minutes per gigabyte for Resize time... Let's be safe with 3
@@ -752,28 +1391,53 @@ func changeLinodeSize(client *linodego.Client, instance *linodego.Instance, d *s
 	// and the filesystem expansion
 	waitSeconds := ((instance.Specs.Disk / 1024) * 180)
 
-	event, err := client.WaitForEventFinished(context.TODO(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize, *instance.Created, waitSeconds)
+	wasRunning := instance.Status == linodego.InstanceRunning
+
+	if plan.shrink() {
+		if err := shutdownLinodeInstance(client, instance); err != nil {
+			return err
+		}
+		if err := resizeLinodeDisk(client, instance, plan.diskID, plan.newDiskSize, waitSeconds); err != nil {
+			return err
+		}
+	}
+
+	if ok, err := client.ResizeInstance(context.TODO(), instance.ID, typeID); err != nil || !ok {
+		return fmt.Errorf("Failed resizing instance %d because %s", instance.ID, err)
+	}
+
+	_, err = client.WaitForEventFinished(context.TODO(), instance.ID, linodego.EntityLinode, linodego.ActionLinodeResize, *instance.Created, waitSeconds)
 	if err != nil {
 		return fmt.Errorf("Failed while waiting for instance %d to finish resizing because %s", instance.ID, err)
 	}
 
-	if d.Get("disk_expansion").(bool) && instance.Specs.Disk > targetType.Disk {
-		// Determine the biggestDisk ID and Size
-		biggestDiskID, biggestDiskSize, err := getBiggestDisk(client, instance.ID)
+	if plan.grow() {
+		resized, err := client.GetInstance(context.TODO(), instance.ID)
 		if err != nil {
+			return fmt.Errorf("Failed to fetch data about Linode instance %d because %s", instance.ID, err)
+		}
+		if resized.Status != linodego.InstanceOffline {
+			if err := shutdownLinodeInstance(client, resized); err != nil {
+				return err
+			}
+		}
+		if err := resizeLinodeDisk(client, instance, plan.diskID, plan.newDiskSize, waitSeconds); err != nil {
 			return err
 		}
-		// Calculate new size, with other disks taken into consideration
-		expandedDiskSize := biggestDiskSize + targetType.Disk - instance.Specs.Disk
-
-		// Resize the Disk
-		client.ResizeInstanceDisk(context.TODO(), instance.ID, biggestDiskID, expandedDiskSize)
+	}
 
-		// Wait for the Disk Resize Operation to Complete
-		// waitForEventComplete(client, instance.ID, "linode_resize", waitMinutes)
-		event, err = client.WaitForEventFinished(context.TODO(), instance.ID, linodego.EntityLinode, linodego.ActionDiskResize, *event.Created, waitSeconds)
+	if wasRunning {
+		resized, err := client.GetInstance(context.TODO(), instance.ID)
 		if err != nil {
-			return fmt.Errorf("Failed to wait for resize of Disk %d for Linode %d because %s", biggestDiskID, instance.ID, err)
+			return fmt.Errorf("Failed to fetch data about Linode instance %d because %s", instance.ID, err)
+		}
+		if resized.Status == linodego.InstanceOffline {
+			if booted, err := client.BootInstance(context.TODO(), instance.ID, 0); !booted {
+				return fmt.Errorf("Failed to boot Linode instance %d because %s", instance.ID, err)
+			}
+		}
+		if err = linodego.WaitForInstanceStatus(context.TODO(), client, instance.ID, linodego.InstanceRunning, WaitTimeout); err != nil {
+			return fmt.Errorf("Timed-out waiting for Linode instance %d to boot because %s", instance.ID, err)
 		}
 	}
 
@@ -783,6 +1447,36 @@ func changeLinodeSize(client *linodego.Client, instance *linodego.Instance, d *s
 	return nil
 }
 
+// flattenInstanceSpecs converts the specs of an instance into the single element list stored in specs
+func flattenInstanceSpecs(specs *linodego.InstanceSpec) []map[string]interface{} {
+	if specs == nil {
+		return nil
+	}
+	return []map[string]interface{}{{
+		"disk":     specs.Disk,
+		"memory":   specs.Memory,
+		"vcpus":    specs.VCPUs,
+		"transfer": specs.Transfer,
+	}}
+}
+
+// formatInstanceTime formats an API timestamp as RFC 3339, or returns "" when it is unknown
+func formatInstanceTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(time.RFC3339)
+}
+
+// expandStringList converts a list of interface{} values from the schema into strings
+func expandStringList(list []interface{}) []string {
+	result := make([]string, 0, len(list))
+	for _, v := range list {
+		result = append(result, v.(string))
+	}
+	return result
+}
+
 // Converts a bool to a string
 func boolToString(val bool) string {
 	if val {
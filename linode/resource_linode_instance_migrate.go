@@ -0,0 +1,102 @@
+package linode
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+// legacyInstanceSizeTypes maps the memory sizes (MB) used by the Linode API v3 plans to the
+// equivalent Linode API v4 types
+var legacyInstanceSizeTypes = map[string]string{
+	"1024":   "g6-nanode-1",
+	"2048":   "g6-standard-1",
+	"4096":   "g6-standard-2",
+	"8192":   "g6-standard-4",
+	"16384":  "g6-standard-6",
+	"32768":  "g6-standard-8",
+	"65536":  "g6-standard-16",
+	"98304":  "g6-standard-20",
+	"131072": "g6-standard-24",
+	"196608": "g6-standard-32",
+}
+
+func resourceLinodeInstanceMigrateState(v int, is *terraform.InstanceState, meta interface{}) (*terraform.InstanceState, error) {
+	switch v {
+	case 0:
+		log.Println("[INFO] Found Linode Instance State v0; migrating to v1")
+		return migrateLinodeInstanceStateV0toV1(is)
+	default:
+		return is, fmt.Errorf("Unexpected schema version: %d", v)
+	}
+}
+
+// migrateLinodeInstanceStateV0toV1 translates the attributes of the Linode API v3 based provider
+// into their current equivalents
+func migrateLinodeInstanceStateV0toV1(is *terraform.InstanceState) (*terraform.InstanceState, error) {
+	if is.Empty() || is.Attributes == nil {
+		log.Println("[DEBUG] Empty Linode Instance State; nothing to migrate.")
+		return is, nil
+	}
+
+	log.Printf("[DEBUG] Linode Instance Attributes before migration: %#v", is.Attributes)
+
+	if name, ok := is.Attributes["name"]; ok {
+		if is.Attributes["label"] == "" {
+			is.Attributes["label"] = name
+		}
+		delete(is.Attributes, "name")
+	}
+
+	if size, ok := is.Attributes["size"]; ok {
+		if _, ok := is.Attributes["type"]; !ok {
+			linodeType, known := legacyInstanceSizeTypes[size]
+			if !known {
+				return is, fmt.Errorf("Failed to migrate Linode instance %s because size %s has no equivalent type", is.ID, size)
+			}
+			is.Attributes["type"] = linodeType
+		}
+		delete(is.Attributes, "size")
+	}
+
+	if group, ok := is.Attributes["group"]; ok {
+		if group != "" {
+			count, _ := strconv.Atoi(is.Attributes["tags.#"])
+			tagged := false
+			for i := 0; i < count; i++ {
+				if is.Attributes[fmt.Sprintf("tags.%d", i)] == group {
+					tagged = true
+				}
+			}
+			if !tagged {
+				is.Attributes[fmt.Sprintf("tags.%d", count)] = group
+				is.Attributes["tags.#"] = strconv.Itoa(count + 1)
+			}
+		}
+		delete(is.Attributes, "group")
+	}
+
+	if helper, ok := is.Attributes["manage_private_ip_automatically"]; ok {
+		if _, ok := is.Attributes["helper_network"]; !ok {
+			is.Attributes["helper_network"] = helper
+		}
+		delete(is.Attributes, "manage_private_ip_automatically")
+	}
+
+	for old, current := range map[string]string{
+		"plan_storage":          "storage",
+		"plan_storage_utilized": "storage_utilized",
+	} {
+		if value, ok := is.Attributes[old]; ok {
+			if _, ok := is.Attributes[current]; !ok {
+				is.Attributes[current] = value
+			}
+			delete(is.Attributes, old)
+		}
+	}
+
+	log.Printf("[DEBUG] Linode Instance Attributes after migration: %#v", is.Attributes)
+	return is, nil
+}
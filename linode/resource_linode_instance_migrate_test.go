@@ -0,0 +1,96 @@
+package linode
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestLinodeInstanceMigrateState(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]struct {
+		StateVersion int
+		Attributes   map[string]string
+		Expected     map[string]string
+	}{
+		"v0_legacy_attributes": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"name":                            "web",
+				"size":                            "2048",
+				"group":                           "production",
+				"manage_private_ip_automatically": "false",
+				"plan_storage":                    "51200",
+				"plan_storage_utilized":           "25600",
+			},
+			Expected: map[string]string{
+				"label":            "web",
+				"type":             "g6-standard-1",
+				"tags.#":           "1",
+				"tags.0":           "production",
+				"helper_network":   "false",
+				"storage":          "51200",
+				"storage_utilized": "25600",
+			},
+		},
+		"v0_current_attributes": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"label":  "web",
+				"type":   "g6-nanode-1",
+				"tags.#": "1",
+				"tags.0": "production",
+				"group":  "production",
+			},
+			Expected: map[string]string{
+				"label":  "web",
+				"type":   "g6-nanode-1",
+				"tags.#": "1",
+				"tags.0": "production",
+			},
+		},
+		"v0_empty_group": {
+			StateVersion: 0,
+			Attributes: map[string]string{
+				"label": "web",
+				"group": "",
+			},
+			Expected: map[string]string{
+				"label": "web",
+			},
+		},
+	}
+
+	for tn, tc := range cases {
+		is := &terraform.InstanceState{
+			ID:         "1234567",
+			Attributes: tc.Attributes,
+		}
+		is, err := resourceLinodeInstanceMigrateState(tc.StateVersion, is, nil)
+		if err != nil {
+			t.Fatalf("bad: %s, err: %#v", tn, err)
+		}
+
+		if len(is.Attributes) != len(tc.Expected) {
+			t.Errorf("bad: %s\n\n expected: %#v -> %#v\n got: %#v", tn, tc.Attributes, tc.Expected, is.Attributes)
+		}
+		for k, v := range tc.Expected {
+			if is.Attributes[k] != v {
+				t.Errorf("bad: %s\n\n expected: %#v -> %#v\n got: %#v -> %#v\n in: %#v", tn, k, v, k, is.Attributes[k], is.Attributes)
+			}
+		}
+	}
+}
+
+func TestLinodeInstanceMigrateState_unknownSize(t *testing.T) {
+	t.Parallel()
+
+	is := &terraform.InstanceState{
+		ID:         "1234567",
+		Attributes: map[string]string{"size": "3000"},
+	}
+	if _, err := resourceLinodeInstanceMigrateState(0, is, nil); err == nil {
+		t.Fatalf("expected an error for a size without an equivalent type")
+	}
+}
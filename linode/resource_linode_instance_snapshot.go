@@ -0,0 +1,191 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+// SnapshotWaitTimeout is the default number of seconds to wait for a Linode instance snapshot to complete
+const SnapshotWaitTimeout = 3600
+
+func resourceLinodeInstanceSnapshot() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeInstanceSnapshotCreate,
+		Read:   resourceLinodeInstanceSnapshotRead,
+		Delete: resourceLinodeInstanceSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceLinodeInstanceSnapshotImport,
+		},
+		Schema: map[string]*schema.Schema{
+			"linode_id": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The ID of the Linode instance to snapshot.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The label of the snapshot.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Description: "Arbitrary values that cause a new snapshot to be taken whenever they change.",
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The status of the snapshot.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the snapshot was started.",
+				Computed:    true,
+			},
+			"finished": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "When the snapshot completed.",
+				Computed:    true,
+			},
+			"configs": &schema.Schema{
+				Type:        schema.TypeList,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The labels of the Linode Configs included in the snapshot.",
+				Computed:    true,
+			},
+			"disks": &schema.Schema{
+				Type:        schema.TypeList,
+				Description: "The disks included in the snapshot.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"size": &schema.Schema{
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"filesystem": &schema.Schema{
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func syncInstanceSnapshotResourceData(d *schema.ResourceData, snapshot *linodego.InstanceSnapshot) {
+	d.Set("label", snapshot.Label)
+	d.Set("status", string(snapshot.Status))
+	d.Set("created", formatInstanceTime(snapshot.Created))
+	d.Set("finished", formatInstanceTime(snapshot.Finished))
+	d.Set("configs", snapshot.Configs)
+	d.Set("disks", flattenInstanceSnapshotDisks(snapshot.Disks))
+}
+
+// flattenInstanceSnapshotDisks converts the disks of a snapshot into a list of maps
+func flattenInstanceSnapshotDisks(disks []*linodego.InstanceSnapshotDisk) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(disks))
+	for _, disk := range disks {
+		result = append(result, map[string]interface{}{
+			"label":      disk.Label,
+			"size":       disk.Size,
+			"filesystem": disk.Filesystem,
+		})
+	}
+	return result
+}
+
+func resourceLinodeInstanceSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode Instance Snapshot ID %s as int because %s", d.Id(), err)
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	snapshot, err := client.GetInstanceSnapshot(context.TODO(), linodeID, int(id))
+	if err != nil {
+		// Taking another snapshot of the instance replaces this one
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Snapshot %d of Linode instance %d no longer exists", id, linodeID)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to find snapshot %d of Linode instance %d because %s", id, linodeID, err)
+	}
+
+	syncInstanceSnapshotResourceData(d, snapshot)
+
+	return nil
+}
+
+func resourceLinodeInstanceSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode Instance Snapshot")
+	}
+	linodeID := d.Get("linode_id").(int)
+
+	instance, err := client.GetInstance(context.TODO(), linodeID)
+	if err != nil {
+		return fmt.Errorf("Failed to find Linode instance %d because %s", linodeID, err)
+	}
+	if instance.Backups == nil || !instance.Backups.Enabled {
+		return fmt.Errorf("Failed to take a snapshot of Linode instance %d because the Backup service is not enabled for it", linodeID)
+	}
+
+	log.Printf("[INFO] Taking a snapshot of Linode instance %d", linodeID)
+	snapshot, err := client.CreateInstanceSnapshot(context.TODO(), linodeID, d.Get("label").(string))
+	if err != nil {
+		return fmt.Errorf("Failed to take a snapshot of Linode instance %d because %s", linodeID, err)
+	}
+	d.SetId(fmt.Sprintf("%d", snapshot.ID))
+
+	if err := linodego.WaitForSnapshotStatus(context.TODO(), &client, linodeID, snapshot.ID, linodego.SnapshotSuccessful, SnapshotWaitTimeout); err != nil {
+		return fmt.Errorf("Failed while waiting for snapshot %d of Linode instance %d to complete because %s", snapshot.ID, linodeID, err)
+	}
+
+	return resourceLinodeInstanceSnapshotRead(d, meta)
+}
+
+// resourceLinodeInstanceSnapshotDelete only forgets the snapshot. The API does not delete snapshots,
+// they are replaced when the next snapshot of the instance is taken.
+func resourceLinodeInstanceSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	d.SetId("")
+	return nil
+}
+
+// resourceLinodeInstanceSnapshotImport imports a snapshot given as "linode_id,snapshot_id"
+func resourceLinodeInstanceSnapshotImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), ",")
+	if len(parts) != 2 {
+		return nil, fmt.Errorf("Failed to import Linode Instance Snapshot %s because the ID must be given as linode_id,snapshot_id", d.Id())
+	}
+
+	linodeID, err := strconv.Atoi(parts[0])
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse Linode instance ID %s as int because %s", parts[0], err)
+	}
+	if _, err := strconv.Atoi(parts[1]); err != nil {
+		return nil, fmt.Errorf("Failed to parse Linode Instance Snapshot ID %s as int because %s", parts[1], err)
+	}
+
+	d.SetId(parts[1])
+	d.Set("linode_id", linodeID)
+
+	return []*schema.ResourceData{d}, nil
+}
@@ -0,0 +1,112 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestFlattenInstanceSnapshotDisks(t *testing.T) {
+	t.Parallel()
+
+	disks := flattenInstanceSnapshotDisks([]*linodego.InstanceSnapshotDisk{
+		{Label: "Ubuntu 18.04 Disk", Size: 25344, Filesystem: "ext4"},
+		{Label: "256MB Swap Image", Size: 256, Filesystem: "swap"},
+	})
+
+	if len(disks) != 2 {
+		t.Fatalf("expected 2 disks, got %d", len(disks))
+	}
+	if disks[1]["filesystem"] != "swap" || disks[1]["size"] != 256 {
+		t.Errorf("unexpected disk %v", disks[1])
+	}
+}
+
+func TestAccLinodeInstanceSnapshotBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance_snapshot.foobar"
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceSnapshotConfigInstance(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					testAccEnableLinodeInstanceBackups("linode_instance.foobar"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceSnapshotConfigBasic(instanceName, "before-migration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "label", instanceName),
+					resource.TestCheckResourceAttr(resName, "status", "successful"),
+					resource.TestCheckResourceAttr(resName, "disks.#", "2"),
+					resource.TestCheckResourceAttrSet(resName, "finished"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceSnapshotConfigBasic(instanceName, "after-migration"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resName, "triggers.migration", "after-migration"),
+					resource.TestCheckResourceAttr(resName, "status", "successful"),
+				),
+			},
+		},
+	})
+}
+
+// testAccEnableLinodeInstanceBackups enables the Backup service, which is needed to take snapshots
+func testAccEnableLinodeInstanceBackups(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testAccProvider.Meta().(linodego.Client)
+
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		return client.EnableInstanceBackups(context.Background(), id)
+	}
+}
+
+func testAccCheckLinodeInstanceSnapshotConfigInstance(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+}`, instance)
+}
+
+func testAccCheckLinodeInstanceSnapshotConfigBasic(instance string, migration string) string {
+	return testAccCheckLinodeInstanceSnapshotConfigInstance(instance) + fmt.Sprintf(`
+
+resource "linode_instance_snapshot" "foobar" {
+	linode_id = "${linode_instance.foobar.id}"
+	label = "%s"
+
+	triggers {
+		migration = "%s"
+	}
+}`, instance, migration)
+}
@@ -2,13 +2,17 @@ package linode
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/config"
 	"github.com/hashicorp/terraform/helper/acctest"
 	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
 	"github.com/hashicorp/terraform/terraform"
 )
 
@@ -41,17 +45,160 @@ func TestAccLinodeInstanceBasic(t *testing.T) {
 					resource.TestCheckResourceAttr(resName, "kernel", "linode/latest-64bit"),
 					// resource.TestCheckResourceAttr(resName, "group", "testing"),
 					resource.TestCheckResourceAttr(resName, "swap_size", "256"),
+					resource.TestCheckResourceAttr(resName, "image_id", "linode/ubuntu18.04"),
+					resource.TestCheckResourceAttr(resName, "watchdog_enabled", "true"),
+					resource.TestCheckResourceAttrSet(resName, "hypervisor"),
+					resource.TestCheckResourceAttrSet(resName, "created"),
+					resource.TestCheckResourceAttrSet(resName, "updated"),
 				),
 			},
 
 			resource.TestStep{
-				ResourceName: resName,
-				ImportState:  true,
+				ResourceName:            resName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"root_password", "ssh_key"},
 			},
 		},
 	})
 }
 
+func TestWriteOnlyDiffSuppressFunc(t *testing.T) {
+	t.Parallel()
+
+	d := resourceLinodeInstance().TestResourceData()
+	if writeOnlyDiffSuppressFunc("root_password", "", "hash", d) {
+		t.Errorf("should not suppress diffs before the instance exists")
+	}
+
+	imported := resourceLinodeInstance().Data(&terraform.InstanceState{
+		ID:         "1234",
+		Attributes: map[string]string{"image": "linode/debian9"},
+	})
+	if !writeOnlyDiffSuppressFunc("root_password", "", "hash", imported) {
+		t.Errorf("should suppress diffs when no value was recorded by the import")
+	}
+	if !writeOnlyDiffSuppressFunc("ssh_key.#", "0", "1", imported) {
+		t.Errorf("should suppress diffs when no keys were recorded by the import")
+	}
+	if writeOnlyDiffSuppressFunc("image", "linode/debian9", "linode/ubuntu18.04", imported) {
+		t.Errorf("should not suppress diffs when a value was recorded by the import")
+	}
+
+	created := resourceLinodeInstance().Data(&terraform.InstanceState{
+		ID:         "1234",
+		Attributes: map[string]string{"root_password": "hash"},
+	})
+	if writeOnlyDiffSuppressFunc("image", "", "linode/debian9", created) {
+		t.Errorf("should not suppress adding an image to a created instance")
+	}
+	if writeOnlyDiffSuppressFunc("ssh_key.#", "0", "1", created) {
+		t.Errorf("should not suppress adding keys to a created instance")
+	}
+	if writeOnlyDiffSuppressFunc("root_password", "hash", "other", created) {
+		t.Errorf("should not suppress diffs when a value was recorded")
+	}
+}
+
+func TestInstanceConfigDeviceDiffSuppressFunc(t *testing.T) {
+	t.Parallel()
+
+	devicesSchema := map[string]*schema.Schema{"devices": resourceLinodeInstance().Schema["devices"]}
+	slot := func(slot string, device map[string]interface{}) []interface{} {
+		return []interface{}{map[string]interface{}{slot: []interface{}{device}}}
+	}
+
+	// Configured slots are never suppressed, whatever was recorded
+	d := schema.TestResourceDataRaw(t, devicesSchema, map[string]interface{}{
+		"devices": slot("sdc", map[string]interface{}{"volume_id": 5}),
+	})
+	if instanceConfigDeviceDiffSuppressFunc("devices.0.sdc.0.volume_id", "", "5", d) {
+		t.Errorf("should not suppress diffs of a configured slot")
+	}
+	if !instanceConfigDeviceDiffSuppressFunc("devices.0.sda.#", "1", "0", d) {
+		t.Errorf("should suppress diffs of a slot that is neither configured nor holds a volume")
+	}
+
+	state := &terraform.InstanceState{
+		ID: "1234",
+		Attributes: map[string]string{
+			"devices.#":                 "1",
+			"devices.0.sda.#":           "1",
+			"devices.0.sda.0.disk_id":   "10",
+			"devices.0.sda.0.volume_id": "0",
+			"devices.0.sdb.#":           "1",
+			"devices.0.sdb.0.disk_id":   "11",
+			"devices.0.sdb.0.volume_id": "0",
+			"devices.0.sdc.#":           "1",
+			"devices.0.sdc.0.disk_id":   "0",
+			"devices.0.sdc.0.volume_id": "5",
+		},
+	}
+
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		detached bool
+	}{
+		{"keeping the disk slots", map[string]interface{}{"devices": slot("sdc", map[string]interface{}{"volume_id": 5})}, false},
+		{"detaching the volume slot", map[string]interface{}{"devices": slot("sda", map[string]interface{}{"disk_id": 10})}, true},
+		{"removing the devices block", map[string]interface{}{}, true},
+	}
+
+	for _, c := range cases {
+		raw, err := config.NewRawConfig(c.raw)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+		diff, err := (&schema.Resource{Schema: devicesSchema}).Diff(state, terraform.NewResourceConfig(raw), nil)
+		if err != nil {
+			t.Fatalf("%s: %s", c.name, err)
+		}
+
+		var attributes map[string]*terraform.ResourceAttrDiff
+		if diff != nil {
+			attributes = diff.Attributes
+		}
+		for k, attr := range attributes {
+			if strings.HasPrefix(k, "devices.0.sda.") || strings.HasPrefix(k, "devices.0.sdb.") {
+				t.Errorf("%s: expected the disk slots to be kept, got %s: %+v", c.name, k, attr)
+			}
+		}
+		if volume, ok := attributes["devices.0.sdc.0.volume_id"]; ok != c.detached || (ok && volume.Old != "5") {
+			t.Errorf("%s: expected the volume to be detached %t, got %+v", c.name, c.detached, attributes)
+		}
+	}
+}
+
+func TestPlanInstanceResize(t *testing.T) {
+	t.Parallel()
+
+	nanode := &linodego.LinodeType{ID: "g6-nanode-1", Disk: 25600}
+	standard := &linodego.LinodeType{ID: "g6-standard-1", Disk: 51200}
+	disks := []*linodego.InstanceDisk{{ID: 1, Size: 256}, {ID: 2, Size: 30000}}
+
+	plan, err := planInstanceResize(standard, nanode, disks, false)
+	if err != nil {
+		t.Fatalf("unexpected error planning a downsize: %s", err)
+	}
+	if !plan.shrink() || plan.diskID != 2 || plan.newDiskSize != 25344 {
+		t.Errorf("expected disk 2 to shrink to 25344, got %+v", plan)
+	}
+
+	disks = []*linodego.InstanceDisk{{ID: 1, Size: 256}, {ID: 2, Size: 25344}}
+	if plan, err = planInstanceResize(nanode, standard, disks, false); err != nil || plan.grow() || plan.shrink() {
+		t.Errorf("expected no disk change without disk_expansion, got %+v (%v)", plan, err)
+	}
+	if plan, err = planInstanceResize(nanode, standard, disks, true); err != nil || !plan.grow() || plan.newDiskSize != 50944 {
+		t.Errorf("expected disk 2 to grow to 50944, got %+v (%v)", plan, err)
+	}
+
+	disks = []*linodego.InstanceDisk{{ID: 1, Size: 26000}, {ID: 2, Size: 26000}}
+	if _, err = planInstanceResize(standard, nanode, disks, false); err == nil {
+		t.Errorf("expected an error when the other disks do not fit the target type")
+	}
+}
+
 func TestAccLinodeInstanceUpdate(t *testing.T) {
 	t.Parallel()
 
@@ -106,9 +253,10 @@ func TestAccLinodeInstanceResize(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists,
 					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-nanode-1"),
-					resource.TestCheckResourceAttr("linode_instance.foobar", "plan_storage_utilized", "25600"),
 					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "25600"),
 					resource.TestCheckResourceAttr("linode_instance.foobar", "storage", "25600"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "specs.0.memory", "1024"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "specs.0.vcpus", "1"),
 				),
 			},
 			// Bump it to a 2048, but don't expand the disk
@@ -117,7 +265,6 @@ func TestAccLinodeInstanceResize(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists,
 					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-standard-1"),
-					resource.TestCheckResourceAttr("linode_instance.foobar", "plan_storage_utilized", "25600"),
 					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "25600"),
 					resource.TestCheckResourceAttr("linode_instance.foobar", "storage", "25600"),
 				),
@@ -134,6 +281,49 @@ func TestAccLinodeInstanceResize(t *testing.T) {
 	})
 }
 
+func TestAccLinodeInstanceDownsizeShrinkDisk(t *testing.T) {
+	t.Parallel()
+
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	publicKeyMaterial, _, err := acctest.RandSSHKeyPair("linode@ssh-acceptance-test")
+	if err != nil {
+		t.Fatalf("Cannot generate test SSH key pair: %s", err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			// Start off with a Linode 1024
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigUpsizeSmall(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-nanode-1"),
+				),
+			},
+			// Bump it to a 2048, and expand the disk
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigUpsizeExpandDisk(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "51200"),
+				),
+			},
+			// Go back down to a 1024, shrinking the disk
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigDownsize(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-nanode-1"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "25600"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLinodeInstanceExpandDisk(t *testing.T) {
 	t.Parallel()
 
@@ -154,7 +344,7 @@ func TestAccLinodeInstanceExpandDisk(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists,
 					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-nanode-1"),
-					resource.TestCheckResourceAttr("linode_instance.foobar", "plan_storage_utilized", "25600"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "25600"),
 				),
 			},
 			// Bump it to a 2048, and expand the disk
@@ -163,7 +353,7 @@ func TestAccLinodeInstanceExpandDisk(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					testAccCheckLinodeInstanceExists,
 					resource.TestCheckResourceAttr("linode_instance.foobar", "type", "g6-standard-1"),
-					resource.TestCheckResourceAttr("linode_instance.foobar", "plan_storage_utilized", "25600"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "storage_utilized", "51200"),
 				),
 			},
 		},
@@ -190,12 +380,200 @@ func TestAccLinodeInstancePrivateNetworking(t *testing.T) {
 					testAccCheckLinodeInstanceExists,
 					testAccCheckLinodeInstanceAttributesPrivateNetworking("linode_instance.foobar"),
 					resource.TestCheckResourceAttr("linode_instance.foobar", "private_networking", "true"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "ipv4.#", "2"),
+					resource.TestCheckResourceAttrSet("linode_instance.foobar", "ipv6.0"),
+					resource.TestCheckResourceAttrSet("linode_instance.foobar", "ip_addresses.0.gateway"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigNoPrivateNetworking(instanceName, publicKeyMaterial),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr("linode_instance.foobar", "private_networking", "false"),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "private_ip_address", ""),
+					resource.TestCheckResourceAttr("linode_instance.foobar", "ipv4.#", "1"),
 				),
 			},
 		},
 	})
 }
 
+func TestFlattenInstanceSpecs(t *testing.T) {
+	t.Parallel()
+
+	specs := flattenInstanceSpecs(&linodego.InstanceSpec{Disk: 25600, Memory: 1024, VCPUs: 1, Transfer: 1000})
+	if len(specs) != 1 {
+		t.Fatalf("expected a single specs block, got %v", specs)
+	}
+	if specs[0]["memory"] != 1024 || specs[0]["vcpus"] != 1 || specs[0]["disk"] != 25600 || specs[0]["transfer"] != 1000 {
+		t.Errorf("unexpected specs %v", specs[0])
+	}
+	if flattenInstanceSpecs(nil) != nil {
+		t.Errorf("expected no specs for nil specs")
+	}
+}
+
+func TestNodeBalancerNodesUsingAddress(t *testing.T) {
+	t.Parallel()
+
+	nodes := []*linodego.NodeBalancerNode{
+		{Label: "web", Address: "192.168.1.10:80"},
+		{Label: "web-tls", Address: "192.168.1.10:443"},
+		{Label: "other", Address: "192.168.1.100:80"},
+	}
+
+	if dependents := nodeBalancerNodesUsingAddress(nodes, "192.168.1.10"); len(dependents) != 2 {
+		t.Errorf("expected 2 nodes using 192.168.1.10, got %d", len(dependents))
+	}
+	if dependents := nodeBalancerNodesUsingAddress(nodes, "192.168.1.1"); len(dependents) != 0 {
+		t.Errorf("expected no nodes using 192.168.1.1, got %d", len(dependents))
+	}
+}
+
+func TestFlattenInstanceIPAddresses(t *testing.T) {
+	t.Parallel()
+
+	// The network of an instance as returned by GET /linode/instances/$id/ips
+	payload := `{
+		"ipv4": {
+			"public": [{"address": "203.0.113.10", "gateway": "203.0.113.1", "subnet_mask": "255.255.255.0", "prefix": 24,
+				"type": "ipv4", "public": true, "rdns": "li10-10.members.linode.com", "linode_id": 123, "region": "us-east"}],
+			"private": [{"address": "192.168.130.10", "gateway": null, "subnet_mask": "255.255.128.0", "prefix": 17,
+				"type": "ipv4", "public": false, "rdns": null, "linode_id": 123, "region": "us-east"}],
+			"shared": []
+		},
+		"ipv6": {
+			"slaac": {"address": "2600:3c03::f03c:91ff:fe24:3a2f", "gateway": "fe80::1", "subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix": 64, "type": "ipv6", "public": true, "rdns": null, "linode_id": 123, "region": "us-east"},
+			"link_local": {"address": "fe80::f03c:91ff:fe24:3a2f", "gateway": "fe80::1", "subnet_mask": "ffff:ffff:ffff:ffff::",
+				"prefix": 64, "type": "ipv6", "public": false, "rdns": null, "linode_id": 123, "region": "us-east"},
+			"global": [{"range": "2600:3c03:e000:1::", "region": "us-east"}]
+		}
+	}`
+	network := &linodego.InstanceIPAddressResponse{}
+	if err := json.Unmarshal([]byte(payload), network); err != nil {
+		t.Fatalf("failed to decode the instance network: %s", err)
+	}
+
+	ipv4, ipv6, ipAddresses := flattenInstanceIPAddresses(network)
+	if len(ipv4) != 2 || ipv4[0] != "203.0.113.10" || ipv4[1] != "192.168.130.10" {
+		t.Errorf("unexpected ipv4 addresses %v", ipv4)
+	}
+	if len(ipv6) != 3 || ipv6[2] != "2600:3c03:e000:1::" {
+		t.Errorf("unexpected ipv6 addresses %v", ipv6)
+	}
+	if len(ipAddresses) != 4 || ipAddresses[0]["gateway"] != "203.0.113.1" {
+		t.Errorf("unexpected ip_addresses %v", ipAddresses)
+	}
+	if len(ipAddresses) > 1 && (ipAddresses[0]["subnet_mask"] != "255.255.255.0" || ipAddresses[1]["subnet_mask"] != "255.255.128.0") {
+		t.Errorf("unexpected subnet masks in ip_addresses %v", ipAddresses)
+	}
+	if len(ipAddresses) > 0 && ipAddresses[0]["rdns"] != "li10-10.members.linode.com" {
+		t.Errorf("unexpected rdns in ip_addresses %v", ipAddresses)
+	}
+
+	if host := connectionHost(network, "private"); host != "192.168.130.10" {
+		t.Errorf("expected private connection host, got %s", host)
+	}
+	if host := connectionHost(network, "ipv6"); host != "2600:3c03::f03c:91ff:fe24:3a2f" {
+		t.Errorf("expected ipv6 connection host, got %s", host)
+	}
+	network.IPv4.Private = nil
+	if host := connectionHost(network, "private"); host != "203.0.113.10" {
+		t.Errorf("expected fallback to the public connection host, got %s", host)
+	}
+}
+
+func TestFlattenInstanceConfigDevices(t *testing.T) {
+	t.Parallel()
+
+	devices := flattenInstanceConfigDevices(&linodego.InstanceConfigDeviceMap{
+		SDA: &linodego.InstanceConfigDevice{DiskID: 100},
+		SDC: &linodego.InstanceConfigDevice{VolumeID: 200},
+		SDD: &linodego.InstanceConfigDevice{},
+	})
+
+	if len(devices) != 1 {
+		t.Fatalf("expected a single devices block, got %v", devices)
+	}
+	if len(devices[0]) != 2 {
+		t.Errorf("expected only the sda and sdc slots, got %v", devices[0])
+	}
+	if sdc := devices[0]["sdc"].([]map[string]interface{}); sdc[0]["volume_id"] != 200 {
+		t.Errorf("expected volume 200 in sdc, got %v", sdc)
+	}
+	if flattenInstanceConfigDevices(nil) != nil {
+		t.Errorf("expected no devices for a nil device map")
+	}
+}
+
+func TestAccLinodeInstanceWatchdog(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigWatchdog(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr(resName, "watchdog_enabled", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigWatchdog(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttr(resName, "watchdog_enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeInstanceVolumeDevice(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_instance.foobar"
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigVolumeDevice(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttrSet(resName, "devices.0.sda.0.disk_id"),
+					resource.TestCheckResourceAttrPair(resName, "devices.0.sdc.0.volume_id", "linode_volume.foobar", "id"),
+					resource.TestCheckResourceAttr(resName, "root_device", "/dev/sda"),
+				),
+			},
+			// Removing the devices block detaches the volume and keeps the disks
+			resource.TestStep{
+				Config: testAccCheckLinodeInstanceConfigVolumeDeviceDetached(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceExists,
+					resource.TestCheckResourceAttrSet(resName, "devices.0.sda.0.disk_id"),
+					resource.TestCheckResourceAttrSet(resName, "devices.0.sdb.0.disk_id"),
+					resource.TestCheckResourceAttr(resName, "devices.0.sdc.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config:   testAccCheckLinodeInstanceConfigVolumeDeviceDetached(instanceName),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
 func testAccCheckLinodeInstanceExists(s *terraform.State) error {
 	client := testAccProvider.Meta().(linodego.Client)
 
@@ -373,3 +751,76 @@ resource "linode_instance" "foobar" {
 	ssh_key = "%s"
 }`, instance, pubkey)
 }
+
+func testAccCheckLinodeInstanceConfigNoPrivateNetworking(instance string, pubkey string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+	private_networking = false
+	ssh_key = "%s"
+}`, instance, pubkey)
+}
+
+func testAccCheckLinodeInstanceConfigWatchdog(instance string, watchdogEnabled bool) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+	watchdog_enabled = %t
+}`, instance, watchdogEnabled)
+}
+
+func testAccCheckLinodeInstanceConfigVolumeDevice(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_volume" "foobar" {
+	label = "%s"
+	region = "us-east"
+}
+
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+	root_device = "/dev/sda"
+
+	devices {
+		sdc {
+			volume_id = "${linode_volume.foobar.id}"
+		}
+	}
+}`, instance, instance)
+}
+
+func testAccCheckLinodeInstanceConfigVolumeDeviceDetached(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_volume" "foobar" {
+	label = "%s"
+	region = "us-east"
+}
+
+resource "linode_instance" "foobar" {
+	label = "%s"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	kernel = "linode/latest-64bit"
+	root_password = "terraform-test"
+	swap_size = 256
+	root_device = "/dev/sda"
+}`, instance, instance)
+}
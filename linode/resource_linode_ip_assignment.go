@@ -0,0 +1,168 @@
+package linode
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log"
+	"sort"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/hashcode"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+func resourceLinodeIPAssignment() *schema.Resource {
+	return &schema.Resource{
+		Create: resourceLinodeIPAssignmentCreate,
+		Read:   resourceLinodeIPAssignmentRead,
+		Update: resourceLinodeIPAssignmentUpdate,
+		Delete: resourceLinodeIPAssignmentDelete,
+		Schema: map[string]*schema.Schema{
+			"region": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The region where the Linode instances and IPv4 addresses reside.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"assignment": &schema.Schema{
+				Type:        schema.TypeSet,
+				Description: "The IPv4 addresses and the Linode instances they should be assigned to. All assignments are applied together.",
+				Required:    true,
+				Set:         ipAssignmentHash,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"address": &schema.Schema{
+							Type:        schema.TypeString,
+							Description: "The IPv4 address to assign.",
+							Required:    true,
+						},
+						"linode_id": &schema.Schema{
+							Type:        schema.TypeInt,
+							Description: "The ID of the Linode instance that should own the address.",
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// ipAssignmentHash hashes an assignment by both address and owner so that a change of owner
+// is seen as a change to the set
+func ipAssignmentHash(v interface{}) int {
+	var buf bytes.Buffer
+	m := v.(map[string]interface{})
+	buf.WriteString(fmt.Sprintf("%s-", m["address"].(string)))
+	buf.WriteString(fmt.Sprintf("%d-", m["linode_id"].(int)))
+	return hashcode.String(buf.String())
+}
+
+// expandIPAssignments converts the assignment set into a list of linodego assignments, sorted by address
+func expandIPAssignments(assignments *schema.Set) []linodego.IPAddressAssignment {
+	list := make([]linodego.IPAddressAssignment, 0, assignments.Len())
+	for _, v := range assignments.List() {
+		m := v.(map[string]interface{})
+		list = append(list, linodego.IPAddressAssignment{
+			Address:  m["address"].(string),
+			LinodeID: m["linode_id"].(int),
+		})
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Address < list[j].Address })
+	return list
+}
+
+// ipAssignmentID identifies the assignment by its region and the addresses it assigns
+func ipAssignmentID(d *schema.ResourceData) string {
+	var addresses bytes.Buffer
+	for _, assignment := range expandIPAssignments(d.Get("assignment").(*schema.Set)) {
+		addresses.WriteString(fmt.Sprintf("%s-", assignment.Address))
+	}
+	return fmt.Sprintf("%s-%d", d.Get("region").(string), hashcode.String(addresses.String()))
+}
+
+func assignIPAddresses(client *linodego.Client, d *schema.ResourceData) error {
+	assignOpts := linodego.IPAddressesAssignOptions{
+		Region:      d.Get("region").(string),
+		Assignments: expandIPAssignments(d.Get("assignment").(*schema.Set)),
+	}
+
+	for _, assignment := range assignOpts.Assignments {
+		log.Printf("[INFO] Assigning IP address %s to Linode instance %d", assignment.Address, assignment.LinodeID)
+	}
+
+	if err := client.AssignIPAddresses(context.TODO(), assignOpts); err != nil {
+		return fmt.Errorf("Failed to assign IP addresses in region %s because %s", assignOpts.Region, err)
+	}
+	return nil
+}
+
+func resourceLinodeIPAssignmentRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	assignments := d.Get("assignment").(*schema.Set)
+	current := make([]interface{}, 0, assignments.Len())
+
+	for _, assignment := range expandIPAssignments(assignments) {
+		ip, err := client.GetIPAddress(context.TODO(), assignment.Address)
+		if err != nil {
+			if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+				log.Printf("[WARN] IP address %s no longer exists, removing it from the assignment", assignment.Address)
+				continue
+			}
+			return fmt.Errorf("Failed to get IP address %s because %s", assignment.Address, err)
+		}
+
+		current = append(current, map[string]interface{}{
+			"address":   ip.Address,
+			"linode_id": ip.LinodeID,
+		})
+	}
+
+	if len(current) == 0 {
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("assignment", current)
+
+	return nil
+}
+
+func resourceLinodeIPAssignmentCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode IP Assignment")
+	}
+
+	if err := assignIPAddresses(&client, d); err != nil {
+		return err
+	}
+
+	d.SetId(ipAssignmentID(d))
+
+	return resourceLinodeIPAssignmentRead(d, meta)
+}
+
+func resourceLinodeIPAssignmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+
+	if d.HasChange("assignment") {
+		if err := assignIPAddresses(&client, d); err != nil {
+			return err
+		}
+		d.SetId(ipAssignmentID(d))
+	}
+
+	return resourceLinodeIPAssignmentRead(d, meta)
+}
+
+// resourceLinodeIPAssignmentDelete only forgets the assignment. Addresses stay with the instances
+// that currently own them, so a replacement instance keeps its addresses when the old instance
+// is destroyed.
+func resourceLinodeIPAssignmentDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[INFO] Removing Linode IP Assignment %s from state, the addresses stay with their current instances", d.Id())
+	d.SetId("")
+	return nil
+}
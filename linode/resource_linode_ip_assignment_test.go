@@ -0,0 +1,170 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/helper/schema"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestExpandIPAssignments(t *testing.T) {
+	t.Parallel()
+
+	assignments := schema.NewSet(ipAssignmentHash, []interface{}{
+		map[string]interface{}{"address": "192.0.2.20", "linode_id": 2},
+		map[string]interface{}{"address": "192.0.2.10", "linode_id": 1},
+	})
+
+	expanded := expandIPAssignments(assignments)
+	if len(expanded) != 2 {
+		t.Fatalf("expected 2 assignments, got %d", len(expanded))
+	}
+	if expanded[0].Address != "192.0.2.10" || expanded[0].LinodeID != 1 {
+		t.Errorf("expected assignments to be sorted by address, got %+v", expanded)
+	}
+	if expanded[1].Address != "192.0.2.20" || expanded[1].LinodeID != 2 {
+		t.Errorf("expected assignments to be sorted by address, got %+v", expanded)
+	}
+}
+
+func TestAccLinodeIPAssignmentSwap(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_ip_assignment.foobar"
+	var instanceName = fmt.Sprintf("tf_test_%s", acctest.RandString(10))
+	var fooAddress, barAddress string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeInstanceDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeIPAssignmentConfigInstances(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeInstanceIPAddress("linode_instance.foo", &fooAddress),
+					testAccCheckLinodeInstanceIPAddress("linode_instance.bar", &barAddress),
+				),
+			},
+			// The addresses are passed as variables so that the swapped assignment stays the same once
+			// the instances report their new addresses
+			resource.TestStep{
+				PreConfig: func() {
+					os.Setenv("TF_VAR_ip_assignment_foo_address", fooAddress)
+					os.Setenv("TF_VAR_ip_assignment_bar_address", barAddress)
+				},
+				Config: testAccCheckLinodeIPAssignmentConfigSwap(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeIPAssignmentOwners(resName),
+					resource.TestCheckResourceAttr(resName, "region", "us-east"),
+					resource.TestCheckResourceAttr(resName, "assignment.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckLinodeInstanceIPAddress records the public address of an instance
+func testAccCheckLinodeInstanceIPAddress(n string, address *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+		*address = rs.Primary.Attributes["ip_address"]
+		if *address == "" {
+			return fmt.Errorf("%s has no ip_address", n)
+		}
+		return nil
+	}
+}
+
+// testAccCheckLinodeIPAssignmentOwners verifies that the API agrees with state about the owner of each address
+func testAccCheckLinodeIPAssignmentOwners(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found %s", n)
+		}
+
+		client := testAccProvider.Meta().(linodego.Client)
+
+		count, err := strconv.Atoi(rs.Primary.Attributes["assignment.#"])
+		if err != nil {
+			return err
+		}
+
+		checked := 0
+		for k, address := range rs.Primary.Attributes {
+			if !strings.HasSuffix(k, ".address") {
+				continue
+			}
+			owner := rs.Primary.Attributes[strings.TrimSuffix(k, "address")+"linode_id"]
+
+			ip, err := client.GetIPAddress(context.Background(), address)
+			if err != nil {
+				return fmt.Errorf("Error retrieving IP address %s: %s", address, err)
+			}
+			if strconv.Itoa(ip.LinodeID) != owner {
+				return fmt.Errorf("IP address %s is owned by %d, expected %s", address, ip.LinodeID, owner)
+			}
+			checked++
+		}
+
+		if checked != count {
+			return fmt.Errorf("Expected to check %d assignments, checked %d", count, checked)
+		}
+		return nil
+	}
+}
+
+func testAccCheckLinodeIPAssignmentConfigInstances(instance string) string {
+	return fmt.Sprintf(`
+resource "linode_instance" "foo" {
+	label = "%s_foo"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_password = "terraform-test"
+	swap_size = 256
+}
+
+resource "linode_instance" "bar" {
+	label = "%s_bar"
+	type = "g6-nanode-1"
+	image = "linode/ubuntu18.04"
+	region = "us-east"
+	root_password = "terraform-test"
+	swap_size = 256
+}`, instance, instance)
+}
+
+func testAccCheckLinodeIPAssignmentConfigSwap(instance string) string {
+	return testAccCheckLinodeIPAssignmentConfigInstances(instance) + `
+
+variable "ip_assignment_foo_address" {}
+
+variable "ip_assignment_bar_address" {}
+
+resource "linode_ip_assignment" "foobar" {
+	region = "us-east"
+
+	assignment {
+		address = "${var.ip_assignment_foo_address}"
+		linode_id = "${linode_instance.bar.id}"
+	}
+
+	assignment {
+		address = "${var.ip_assignment_bar_address}"
+		linode_id = "${linode_instance.foo.id}"
+	}
+}`
+}
@@ -0,0 +1,357 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/schema"
+)
+
+var (
+	// stackscriptUDFPattern matches the <UDF ... /> tags declaring the variables of a StackScript
+	stackscriptUDFPattern = regexp.MustCompile(`(?is)<udf\s([^>]*?)/?>`)
+
+	// stackscriptUDFAttributePattern matches a name="value" attribute of a UDF tag
+	stackscriptUDFAttributePattern = regexp.MustCompile(`(?s)([A-Za-z_]+)\s*=\s*(?:"([^"]*)"|'([^']*)')`)
+)
+
+func resourceLinodeStackscript() *schema.Resource {
+	return &schema.Resource{
+		Create:        resourceLinodeStackscriptCreate,
+		Read:          resourceLinodeStackscriptRead,
+		Update:        resourceLinodeStackscriptUpdate,
+		Delete:        resourceLinodeStackscriptDelete,
+		Exists:        resourceLinodeStackscriptExists,
+		CustomizeDiff: resourceLinodeStackscriptCustomizeDiff,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+		Schema: map[string]*schema.Schema{
+			"label": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The StackScript's label is for display purposes only.",
+				Required:    true,
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "A description for the StackScript.",
+				Optional:    true,
+			},
+			"images": &schema.Schema{
+				Type:        schema.TypeSet,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "An array of Image IDs representing the Images that this StackScript is compatible for deploying with.",
+				Required:    true,
+			},
+			"is_public": &schema.Schema{
+				Type:        schema.TypeBool,
+				Description: "This determines whether other users can use your StackScript. Once a StackScript is made public, it cannot be made private.",
+				Optional:    true,
+				Default:     false,
+			},
+			"rev_note": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "This field allows you to add notes for the set of revisions made to this StackScript.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"script": &schema.Schema{
+				Type:         schema.TypeString,
+				Description:  "The script to execute when provisioning a new Linode with this StackScript. It must begin with a shebang, e.g. #!/bin/bash.",
+				Required:     true,
+				ValidateFunc: validateStackscriptScript,
+			},
+			"username": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The User who created the StackScript.",
+				Computed:    true,
+			},
+			"deployments_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "The total number of times this StackScript has been deployed.",
+				Computed:    true,
+			},
+			"deployments_active": &schema.Schema{
+				Type:        schema.TypeInt,
+				Description: "Count of currently active, deployed Linodes created from this StackScript.",
+				Computed:    true,
+			},
+			"created": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The date this StackScript was created.",
+				Computed:    true,
+			},
+			"updated": &schema.Schema{
+				Type:        schema.TypeString,
+				Description: "The date this StackScript was updated.",
+				Computed:    true,
+			},
+			"user_defined_fields": stackscriptUDFSchema(),
+		},
+	}
+}
+
+// stackscriptUDFSchema returns the schema of the variables declared by the UDF tags of a StackScript
+func stackscriptUDFSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		Description: "The variables declared by the <UDF /> tags of the script, in the order they appear.",
+		Computed:    true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"name": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "The name of the field.",
+					Computed:    true,
+				},
+				"label": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "A human-readable label for the field that will serve as the input prompt for entering the value during deployment.",
+					Computed:    true,
+				},
+				"example": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "An example value for the field.",
+					Computed:    true,
+				},
+				"default": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "The default value. If not specified, this value will be used.",
+					Computed:    true,
+				},
+				"one_of": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "A comma separated list of acceptable single values for the field.",
+					Computed:    true,
+				},
+				"many_of": &schema.Schema{
+					Type:        schema.TypeString,
+					Description: "A comma separated list of acceptable values for the field in any quantity, combination or order.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+// parseStackscriptUDFs extracts the variables declared by the <UDF /> tags of a script. Every field is
+// returned, an error reports tags without a name and names declared more than once.
+func parseStackscriptUDFs(script string) ([]linodego.StackscriptUDF, error) {
+	var udfs []linodego.StackscriptUDF
+	var problems []string
+	seen := map[string]bool{}
+
+	for _, tag := range stackscriptUDFPattern.FindAllStringSubmatch(script, -1) {
+		var udf linodego.StackscriptUDF
+		for _, attribute := range stackscriptUDFAttributePattern.FindAllStringSubmatch(tag[1], -1) {
+			value := attribute[2] + attribute[3]
+			switch strings.ToLower(attribute[1]) {
+			case "name":
+				udf.Name = value
+			case "label":
+				udf.Label = value
+			case "example":
+				udf.Example = value
+			case "default":
+				udf.Default = value
+			case "oneof":
+				udf.OneOf = value
+			case "manyof":
+				udf.ManyOf = value
+			}
+		}
+
+		switch {
+		case udf.Name == "":
+			problems = append(problems, fmt.Sprintf("%s has no name", strings.TrimSpace(tag[0])))
+			continue
+		case seen[udf.Name]:
+			problems = append(problems, fmt.Sprintf("the UDF %s is declared more than once", udf.Name))
+			continue
+		}
+		seen[udf.Name] = true
+		udfs = append(udfs, udf)
+	}
+
+	if len(problems) > 0 {
+		return udfs, fmt.Errorf("Invalid UDF tags: %s", strings.Join(problems, ", "))
+	}
+	return udfs, nil
+}
+
+// flattenStackscriptUDFs converts the variables of a StackScript to the user_defined_fields attribute
+func flattenStackscriptUDFs(udfs []linodego.StackscriptUDF) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(udfs))
+	for _, udf := range udfs {
+		result = append(result, map[string]interface{}{
+			"name":    udf.Name,
+			"label":   udf.Label,
+			"example": udf.Example,
+			"default": udf.Default,
+			"one_of":  udf.OneOf,
+			"many_of": udf.ManyOf,
+		})
+	}
+	return result
+}
+
+func validateStackscriptScript(v interface{}, k string) (ws []string, errors []error) {
+	script := v.(string)
+	if !strings.HasPrefix(script, "#!") {
+		errors = append(errors, fmt.Errorf("%q must begin with a shebang, e.g. #!/bin/bash", k))
+	}
+	if _, err := parseStackscriptUDFs(script); err != nil {
+		errors = append(errors, fmt.Errorf("%q: %s", k, err))
+	}
+	return
+}
+
+// resourceLinodeStackscriptCustomizeDiff plans the variables declared by a changed script and refuses
+// to make a public StackScript private, which the API does not allow
+func resourceLinodeStackscriptCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && d.HasChange("is_public") {
+		if o, n := d.GetChange("is_public"); o.(bool) && !n.(bool) {
+			return fmt.Errorf("Linode StackScript %s is public and cannot be made private again", d.Id())
+		}
+	}
+
+	if !d.NewValueKnown("script") {
+		return d.SetNewComputed("user_defined_fields")
+	}
+	if d.HasChange("script") {
+		udfs, _ := parseStackscriptUDFs(d.Get("script").(string))
+		return d.SetNew("user_defined_fields", flattenStackscriptUDFs(udfs))
+	}
+	return nil
+}
+
+func syncStackscriptResourceData(d *schema.ResourceData, stackscript *linodego.Stackscript) {
+	d.Set("label", stackscript.Label)
+	d.Set("description", stackscript.Description)
+	d.Set("images", stackscript.Images)
+	d.Set("is_public", stackscript.IsPublic)
+	d.Set("rev_note", stackscript.RevNote)
+	d.Set("script", stackscript.Script)
+	d.Set("username", stackscript.Username)
+	d.Set("deployments_total", stackscript.DeploymentsTotal)
+	d.Set("deployments_active", stackscript.DeploymentsActive)
+	d.Set("created", stackscript.CreatedStr)
+	d.Set("updated", stackscript.UpdatedStr)
+
+	udfs, err := parseStackscriptUDFs(stackscript.Script)
+	if err != nil {
+		log.Printf("[WARN] Linode StackScript %d: %s", stackscript.ID, err)
+	}
+	d.Set("user_defined_fields", flattenStackscriptUDFs(udfs))
+}
+
+func resourceLinodeStackscriptExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return false, fmt.Errorf("Failed to parse Linode StackScript ID %s as int because %s", d.Id(), err)
+	}
+
+	_, err = client.GetStackscript(context.TODO(), int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			return false, nil
+		}
+		return false, fmt.Errorf("Failed to get Linode StackScript %s because %s", d.Id(), err)
+	}
+	return true, nil
+}
+
+func resourceLinodeStackscriptRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode StackScript ID %s as int because %s", d.Id(), err)
+	}
+
+	stackscript, err := client.GetStackscript(context.TODO(), int(id))
+	if err != nil {
+		if lerr, ok := err.(*linodego.Error); ok && lerr.Code == 404 {
+			log.Printf("[WARN] Linode StackScript %s no longer exists", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("Failed to find the specified Linode StackScript because %s", err)
+	}
+
+	syncStackscriptResourceData(d, stackscript)
+
+	return nil
+}
+
+func resourceLinodeStackscriptCreate(d *schema.ResourceData, meta interface{}) error {
+	client, ok := meta.(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Invalid Client when creating Linode StackScript")
+	}
+
+	createOpts := linodego.StackscriptCreateOptions{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Images:      expandStringSet(d.Get("images").(*schema.Set)),
+		IsPublic:    d.Get("is_public").(bool),
+		RevNote:     d.Get("rev_note").(string),
+		Script:      d.Get("script").(string),
+	}
+
+	log.Printf("[INFO] Creating Linode StackScript %s", createOpts.Label)
+	stackscript, err := client.CreateStackscript(context.TODO(), &createOpts)
+	if err != nil {
+		return fmt.Errorf("Failed to create a Linode StackScript because %s", err)
+	}
+	d.SetId(fmt.Sprintf("%d", stackscript.ID))
+	syncStackscriptResourceData(d, stackscript)
+
+	return nil
+}
+
+func resourceLinodeStackscriptUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode StackScript ID %s as int because %s", d.Id(), err)
+	}
+
+	updateOpts := linodego.StackscriptUpdateOptions{
+		Label:       d.Get("label").(string),
+		Description: d.Get("description").(string),
+		Images:      expandStringSet(d.Get("images").(*schema.Set)),
+		IsPublic:    d.Get("is_public").(bool),
+		RevNote:     d.Get("rev_note").(string),
+		Script:      d.Get("script").(string),
+	}
+
+	stackscript, err := client.UpdateStackscript(context.TODO(), int(id), updateOpts)
+	if err != nil {
+		return fmt.Errorf("Failed to update Linode StackScript %d because %s", id, err)
+	}
+	syncStackscriptResourceData(d, stackscript)
+
+	return nil
+}
+
+func resourceLinodeStackscriptDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(linodego.Client)
+	id, err := strconv.ParseInt(d.Id(), 10, 64)
+	if err != nil {
+		return fmt.Errorf("Failed to parse Linode StackScript ID %s as int because %s", d.Id(), err)
+	}
+	if err := client.DeleteStackscript(context.TODO(), int(id)); err != nil {
+		if lerr, ok := err.(*linodego.Error); !ok || lerr.Code != 404 {
+			return fmt.Errorf("Failed to delete Linode StackScript %d because %s", id, err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,265 @@
+package linode
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/chiefy/linodego"
+	"github.com/hashicorp/terraform/helper/acctest"
+	"github.com/hashicorp/terraform/helper/resource"
+	"github.com/hashicorp/terraform/terraform"
+)
+
+func TestParseStackscriptUDFs(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		script   string
+		expected []linodego.StackscriptUDF
+		err      bool
+	}{
+		{
+			name:   "no tags",
+			script: "#!/bin/bash\necho hello\n",
+		},
+		{
+			name: "attributes",
+			script: `#!/bin/bash
+# <UDF name="hostname" label="The hostname" example="web1" />
+# <UDF name="role" Label='The role' default="web" oneOf="web,db" />
+#<udf name="packages" label="Extra packages" manyof="git,vim,htop">
+echo "$HOSTNAME"
+`,
+			expected: []linodego.StackscriptUDF{
+				{Name: "hostname", Label: "The hostname", Example: "web1"},
+				{Name: "role", Label: "The role", Default: "web", OneOf: "web,db"},
+				{Name: "packages", Label: "Extra packages", ManyOf: "git,vim,htop"},
+			},
+		},
+		{
+			name: "tag spanning lines",
+			script: `#!/bin/bash
+# <UDF name="token"
+#      label="API token" />
+`,
+			expected: []linodego.StackscriptUDF{
+				{Name: "token", Label: "API token"},
+			},
+		},
+		{
+			name: "missing name",
+			script: `#!/bin/bash
+# <UDF label="Nameless" />
+# <UDF name="kept" label="Kept" />
+`,
+			expected: []linodego.StackscriptUDF{
+				{Name: "kept", Label: "Kept"},
+			},
+			err: true,
+		},
+		{
+			name: "duplicate name",
+			script: `#!/bin/bash
+# <UDF name="user" label="User" />
+# <UDF name="user" label="Another user" />
+`,
+			expected: []linodego.StackscriptUDF{
+				{Name: "user", Label: "User"},
+			},
+			err: true,
+		},
+	}
+
+	for _, c := range cases {
+		udfs, err := parseStackscriptUDFs(c.script)
+		if (err != nil) != c.err {
+			t.Errorf("%s: unexpected error %v", c.name, err)
+		}
+		if !reflect.DeepEqual(udfs, c.expected) {
+			t.Errorf("%s: expected %+v, got %+v", c.name, c.expected, udfs)
+		}
+	}
+}
+
+func TestAccLinodeStackscriptBasic(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_stackscript.foobar"
+	var stackscriptName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeStackscriptDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeStackscriptConfigBasic(stackscriptName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeStackscriptExists,
+					resource.TestCheckResourceAttr(resName, "label", stackscriptName),
+					resource.TestCheckResourceAttr(resName, "is_public", "false"),
+					resource.TestCheckResourceAttr(resName, "images.#", "1"),
+					resource.TestCheckResourceAttr(resName, "deployments_total", "0"),
+					resource.TestCheckResourceAttr(resName, "deployments_active", "0"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.#", "1"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.0.name", "hostname"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.0.default", "web1"),
+				),
+			},
+
+			resource.TestStep{
+				ResourceName:      resName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLinodeStackscriptUpdate(t *testing.T) {
+	t.Parallel()
+
+	resName := "linode_stackscript.foobar"
+	var stackscriptName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeStackscriptDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckLinodeStackscriptConfigBasic(stackscriptName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeStackscriptExists,
+					resource.TestCheckResourceAttr(resName, "label", stackscriptName),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckLinodeStackscriptConfigUpdates(stackscriptName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLinodeStackscriptExists,
+					resource.TestCheckResourceAttr(resName, "label", fmt.Sprintf("%s_renamed", stackscriptName)),
+					resource.TestCheckResourceAttr(resName, "rev_note", "add role"),
+					resource.TestCheckResourceAttr(resName, "images.#", "2"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.#", "2"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.1.name", "role"),
+					resource.TestCheckResourceAttr(resName, "user_defined_fields.1.one_of", "web,db"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLinodeStackscriptInvalidScript(t *testing.T) {
+	t.Parallel()
+
+	var stackscriptName = acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLinodeStackscriptDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config:      testAccCheckLinodeStackscriptConfigInvalidScript(stackscriptName),
+				ExpectError: regexp.MustCompile("must begin with a shebang"),
+			},
+		},
+	})
+}
+
+func testAccCheckLinodeStackscriptExists(s *terraform.State) error {
+	client := testAccProvider.Meta().(linodego.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_stackscript" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+
+		_, err = client.GetStackscript(context.Background(), id)
+		if err != nil {
+			return fmt.Errorf("Error retrieving state of StackScript %s: %s", rs.Primary.Attributes["label"], err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeStackscriptDestroy(s *terraform.State) error {
+	client, ok := testAccProvider.Meta().(linodego.Client)
+	if !ok {
+		return fmt.Errorf("Failed to get Linode client")
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "linode_stackscript" {
+			continue
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Failed parsing %v to int", rs.Primary.ID)
+		}
+
+		_, err = client.GetStackscript(context.Background(), id)
+
+		if err == nil {
+			return fmt.Errorf("Linode StackScript with id %d still exists", id)
+		}
+
+		if apiErr, ok := err.(*linodego.Error); ok && apiErr.Code != 404 {
+			return fmt.Errorf("Failed to request Linode StackScript with id %d", id)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckLinodeStackscriptConfigBasic(label string) string {
+	return fmt.Sprintf(`
+resource "linode_stackscript" "foobar" {
+	label = "%s"
+	description = "tf-test stackscript"
+	images = ["linode/debian9"]
+	script = <<EOF
+#!/bin/bash
+# <UDF name="hostname" label="The hostname of the Linode" default="web1" />
+hostnamectl set-hostname "$HOSTNAME"
+EOF
+}`, label)
+}
+
+func testAccCheckLinodeStackscriptConfigUpdates(label string) string {
+	return fmt.Sprintf(`
+resource "linode_stackscript" "foobar" {
+	label = "%s_renamed"
+	description = "tf-test stackscript"
+	images = ["linode/debian9", "linode/ubuntu18.04"]
+	rev_note = "add role"
+	script = <<EOF
+#!/bin/bash
+# <UDF name="hostname" label="The hostname of the Linode" default="web1" />
+# <UDF name="role" label="The role of the Linode" oneof="web,db" />
+hostnamectl set-hostname "$HOSTNAME"
+echo "$ROLE" > /etc/role
+EOF
+}`, label)
+}
+
+func testAccCheckLinodeStackscriptConfigInvalidScript(label string) string {
+	return fmt.Sprintf(`
+resource "linode_stackscript" "foobar" {
+	label = "%s"
+	images = ["linode/debian9"]
+	script = "echo missing shebang"
+}`, label)
+}
@@ -0,0 +1,540 @@
+package linode
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/chiefy/linodego"
+)
+
+// zoneFileToken is a word of a zone file, quoted tokens are kept apart as they may contain spaces
+type zoneFileToken struct {
+	text   string
+	quoted bool
+}
+
+// zoneFileEntry is a directive or a record of a zone file, joined across lines when it uses parentheses
+type zoneFileEntry struct {
+	line       int
+	blankOwner bool
+	tokens     []zoneFileToken
+}
+
+// zoneFileError lists every problem found in a zone file, with the line it was found on
+type zoneFileError struct {
+	problems []string
+}
+
+func (e *zoneFileError) Error() string {
+	return fmt.Sprintf("Failed to parse the zone file because of %d problem(s):\n  %s", len(e.problems), strings.Join(e.problems, "\n  "))
+}
+
+// parseZoneFile parses the records of a BIND zone file for the given domain. The $ORIGIN starts as the
+// domain, and relative names are resolved against the current $ORIGIN. SOA records are skipped with a
+// warning, since the SOA of a Linode Domain is built from its settings. Every record type that Linode
+// Domains do not support is reported in the returned error.
+func parseZoneFile(content, domain string) (records []*linodego.DomainRecord, warnings []string, err error) {
+	entries, problems := tokenizeZoneFile(content)
+
+	zone := strings.ToLower(strings.TrimSuffix(domain, ".")) + "."
+	origin := zone
+	defaultTTL, lastTTL := -1, -1
+	lastOwner := ""
+
+	for _, entry := range entries {
+		problem := func(format string, args ...interface{}) {
+			problems = append(problems, fmt.Sprintf("line %d: %s", entry.line, fmt.Sprintf(format, args...)))
+		}
+		tokens := entry.tokens
+
+		if !entry.blankOwner && strings.HasPrefix(tokens[0].text, "$") {
+			switch strings.ToUpper(tokens[0].text) {
+			case "$ORIGIN":
+				if len(tokens) != 2 {
+					problem("$ORIGIN requires a single domain name")
+					continue
+				}
+				origin = zoneFileAbsoluteName(tokens[1].text, origin)
+			case "$TTL":
+				ttl, err := parseZoneFileTTL(tokensText(tokens[1:]))
+				if len(tokens) != 2 || err != nil {
+					problem("$TTL requires a single TTL value")
+					continue
+				}
+				defaultTTL = ttl
+			default:
+				problem("the %s directive is not supported", tokens[0].text)
+			}
+			continue
+		}
+
+		owner := lastOwner
+		if !entry.blankOwner {
+			owner = zoneFileAbsoluteName(tokens[0].text, origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			problem("the record has no owner name")
+			continue
+		}
+		lastOwner = owner
+
+		ttl := -1
+		for len(tokens) > 0 && !tokens[0].quoted {
+			if t, err := parseZoneFileTTL(tokens[0].text); err == nil && ttl < 0 {
+				ttl = t
+			} else if class := strings.ToUpper(tokens[0].text); class == "IN" || class == "CH" || class == "HS" || class == "CS" {
+				if class != "IN" {
+					problem("the %s class is not supported, only IN", class)
+				}
+			} else {
+				break
+			}
+			tokens = tokens[1:]
+		}
+		if len(tokens) == 0 {
+			problem("the record has no type")
+			continue
+		}
+		if ttl >= 0 {
+			lastTTL = ttl
+		} else if defaultTTL >= 0 {
+			ttl = defaultTTL
+		} else if lastTTL >= 0 {
+			ttl = lastTTL
+		} else {
+			ttl = 0
+		}
+
+		recordType := strings.ToUpper(tokens[0].text)
+		rdata := tokens[1:]
+
+		if recordType == "SOA" {
+			warnings = append(warnings, fmt.Sprintf("line %d: the SOA record is skipped, it is built from the settings of the Linode Domain", entry.line))
+			continue
+		}
+
+		name, err := zoneFileRecordName(owner, zone)
+		if err != nil {
+			problem("%s", err)
+			continue
+		}
+
+		record, err := parseZoneFileRecord(recordType, name, rdata, origin)
+		if err != nil {
+			problem("%s", err)
+			continue
+		}
+		record.TTLSec = normalizeDomainSeconds(ttl)
+		if err := validateDomainRecord(record); err != nil {
+			problem("%s", err)
+			continue
+		}
+		records = append(records, record)
+	}
+
+	if len(problems) > 0 {
+		return nil, warnings, &zoneFileError{problems: problems}
+	}
+	return records, warnings, nil
+}
+
+// parseZoneFileRecord converts the data of a record into a Linode Domain Record
+func parseZoneFileRecord(recordType, name string, rdata []zoneFileToken, origin string) (*linodego.DomainRecord, error) {
+	expect := func(count int, format string) error {
+		if len(rdata) != count {
+			return fmt.Errorf("%s records must be given as %s", recordType, format)
+		}
+		return nil
+	}
+	number := func(token zoneFileToken, field string) (int, error) {
+		n, err := strconv.Atoi(token.text)
+		if err != nil {
+			return 0, fmt.Errorf("the %s of %s records must be a number, got %s", field, recordType, token.text)
+		}
+		return n, nil
+	}
+	hostname := func(token zoneFileToken) string {
+		return strings.TrimSuffix(zoneFileAbsoluteName(token.text, origin), ".")
+	}
+
+	record := &linodego.DomainRecord{Type: linodego.DomainRecordType(recordType), Name: name}
+
+	switch linodego.DomainRecordType(recordType) {
+	case linodego.RecordTypeA, linodego.RecordTypeAAAA:
+		if err := expect(1, "<address>"); err != nil {
+			return nil, err
+		}
+		record.Target = rdata[0].text
+	case linodego.RecordTypeNS, linodego.RecordTypeCNAME:
+		if err := expect(1, "<hostname>"); err != nil {
+			return nil, err
+		}
+		record.Target = hostname(rdata[0])
+	case linodego.RecordTypeMX:
+		if err := expect(2, "<preference> <hostname>"); err != nil {
+			return nil, err
+		}
+		priority, err := number(rdata[0], "preference")
+		if err != nil {
+			return nil, err
+		}
+		record.Priority = priority
+		record.Target = hostname(rdata[1])
+	case linodego.RecordTypeTXT:
+		if len(rdata) == 0 {
+			return nil, fmt.Errorf("TXT records require at least one string")
+		}
+		// Multiple quoted strings are joined, as they are by resolvers
+		separator := " "
+		if rdata[0].quoted {
+			separator = ""
+		}
+		var parts []string
+		for _, token := range rdata {
+			parts = append(parts, token.text)
+		}
+		record.Target = strings.Join(parts, separator)
+	case linodego.RecordTypeSRV:
+		if err := expect(4, "<priority> <weight> <port> <target>"); err != nil {
+			return nil, err
+		}
+		labels := strings.SplitN(name, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return nil, fmt.Errorf("the name of SRV records must start with _service._protocol, got %s", name)
+		}
+		service, protocol := strings.TrimPrefix(labels[0], "_"), strings.TrimPrefix(labels[1], "_")
+		record.Service, record.Protocol = &service, &protocol
+		record.Name = ""
+		if len(labels) == 3 {
+			record.Name = labels[2]
+		}
+		fields := []*int{&record.Priority, &record.Weight, &record.Port}
+		for i, field := range []string{"priority", "weight", "port"} {
+			n, err := number(rdata[i], field)
+			if err != nil {
+				return nil, err
+			}
+			*fields[i] = n
+		}
+		record.Target = hostname(rdata[3])
+	case linodego.RecordTypeCAA:
+		if err := expect(3, "<flags> <tag> <value>"); err != nil {
+			return nil, err
+		}
+		flags, err := number(rdata[0], "flags")
+		if err != nil {
+			return nil, err
+		}
+		// Linode Domains have no CAA flags, so a critical record would silently lose its flag
+		if flags != 0 {
+			return nil, fmt.Errorf("the flags of CAA records are not supported by Linode Domains, only 0 is, got %d", flags)
+		}
+		tag := strings.ToLower(rdata[1].text)
+		record.Tag = &tag
+		record.Target = rdata[2].text
+	default:
+		return nil, fmt.Errorf("the %s record type is not supported by Linode Domains, only A, AAAA, NS, MX, CNAME, TXT, SRV and CAA records are", recordType)
+	}
+
+	return record, nil
+}
+
+// tokenizeZoneFile splits a zone file into its entries, removing comments and joining the lines of
+// entries that use parentheses
+func tokenizeZoneFile(content string) (entries []zoneFileEntry, problems []string) {
+	var current *zoneFileEntry
+	depth := 0
+
+	for i, line := range strings.Split(content, "\n") {
+		lineNumber := i + 1
+		if current == nil {
+			current = &zoneFileEntry{
+				line:       lineNumber,
+				blankOwner: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		runes := []rune(line)
+		for pos := 0; pos < len(runes); {
+			r := runes[pos]
+			switch {
+			case r == ';':
+				pos = len(runes)
+			case unicode.IsSpace(r):
+				pos++
+			case r == '(':
+				depth++
+				pos++
+			case r == ')':
+				if depth == 0 {
+					problems = append(problems, fmt.Sprintf("line %d: unbalanced closing parenthesis", lineNumber))
+				} else {
+					depth--
+				}
+				pos++
+			case r == '"':
+				var text strings.Builder
+				pos++
+				for pos < len(runes) && runes[pos] != '"' {
+					if runes[pos] == '\\' && pos+1 < len(runes) {
+						pos++
+					}
+					text.WriteRune(runes[pos])
+					pos++
+				}
+				if pos == len(runes) {
+					problems = append(problems, fmt.Sprintf("line %d: unterminated quoted string", lineNumber))
+				}
+				pos++
+				current.tokens = append(current.tokens, zoneFileToken{text: text.String(), quoted: true})
+			default:
+				start := pos
+				for pos < len(runes) && !unicode.IsSpace(runes[pos]) && !strings.ContainsRune(";()\"", runes[pos]) {
+					pos++
+				}
+				current.tokens = append(current.tokens, zoneFileToken{text: string(runes[start:pos])})
+			}
+		}
+
+		if depth > 0 {
+			continue
+		}
+		if len(current.tokens) > 0 {
+			entries = append(entries, *current)
+		}
+		current = nil
+	}
+
+	if depth > 0 && current != nil {
+		problems = append(problems, fmt.Sprintf("line %d: unbalanced opening parenthesis", current.line))
+	}
+	return entries, problems
+}
+
+// parseZoneFileTTL parses a TTL in seconds, or using the s, m, h, d and w units, e.g. 1h30m
+func parseZoneFileTTL(text string) (int, error) {
+	if text == "" || !unicode.IsDigit(rune(text[0])) {
+		return 0, fmt.Errorf("invalid TTL %q", text)
+	}
+	if n, err := strconv.Atoi(text); err == nil {
+		return n, nil
+	}
+
+	units := map[rune]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, value, digits := 0, 0, false
+	for _, r := range strings.ToLower(text) {
+		if unicode.IsDigit(r) {
+			value = value*10 + int(r-'0')
+			digits = true
+			continue
+		}
+		unit, ok := units[r]
+		if !ok || !digits {
+			return 0, fmt.Errorf("invalid TTL %q", text)
+		}
+		total += value * unit
+		value, digits = 0, false
+	}
+	if digits {
+		return 0, fmt.Errorf("invalid TTL %q", text)
+	}
+	return total, nil
+}
+
+// zoneFileAbsoluteName resolves a name of a zone file against the origin, returning a lowercase name
+// with a trailing dot
+func zoneFileAbsoluteName(name, origin string) string {
+	name = strings.ToLower(name)
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+// zoneFileRecordName returns the name of a record relative to the zone, as used by Linode Domain Records
+func zoneFileRecordName(owner, zone string) (string, error) {
+	if owner == zone {
+		return "", nil
+	}
+	if strings.HasSuffix(owner, "."+zone) {
+		return strings.TrimSuffix(owner, "."+zone), nil
+	}
+	return "", fmt.Errorf("the name %s is outside of the zone %s", owner, zone)
+}
+
+func tokensText(tokens []zoneFileToken) string {
+	var parts []string
+	for _, token := range tokens {
+		parts = append(parts, token.text)
+	}
+	return strings.Join(parts, " ")
+}
+
+// The SOA settings used by Linode when the settings of a Domain are left at zero
+const (
+	domainDefaultRefreshSec = 14400
+	domainDefaultRetrySec   = 14400
+	domainDefaultExpireSec  = 1209600
+	domainDefaultTTLSec     = 86400
+)
+
+// linodeNameservers are the nameservers serving every master Linode Domain
+var linodeNameservers = []string{"ns1.linode.com", "ns2.linode.com", "ns3.linode.com", "ns4.linode.com", "ns5.linode.com"}
+
+// renderZoneFile renders a master Domain and its records as a canonical BIND zone file. The records
+// are sorted by name, type and data, so the same zone always renders the same file. The serial of the
+// SOA is taken from the latest update of the Domain or its records, see zoneFileSerial.
+func renderZoneFile(domain *linodego.Domain, records []*linodego.DomainRecord) string {
+	withDefault := func(value, defaultValue int) int {
+		if value == 0 {
+			return defaultValue
+		}
+		return value
+	}
+
+	lines := make([]zoneFileLine, 0, len(records)+len(linodeNameservers))
+	for _, ns := range linodeNameservers {
+		lines = append(lines, zoneFileLine{name: "@", recordType: "NS", rdata: ns + "."})
+	}
+	for _, record := range records {
+		lines = append(lines, renderZoneFileRecord(record))
+	}
+	sort.SliceStable(lines, func(i, j int) bool { return lines[i].less(lines[j]) })
+
+	var body bytes.Buffer
+	for _, line := range lines {
+		ttl := ""
+		if line.ttl > 0 {
+			ttl = strconv.Itoa(line.ttl)
+		}
+		body.WriteString(fmt.Sprintf("%s\t%s\tIN\t%s\t%s\n", line.name, ttl, line.recordType, line.rdata))
+	}
+
+	var zone bytes.Buffer
+	zone.WriteString(fmt.Sprintf("$ORIGIN %s.\n", strings.TrimSuffix(domain.Domain, ".")))
+	zone.WriteString(fmt.Sprintf("$TTL %d\n", withDefault(domain.TTLSec, domainDefaultTTLSec)))
+	zone.WriteString(fmt.Sprintf("@\t\tIN\tSOA\t%s. %s (\n", linodeNameservers[0], zoneFileMailbox(domain.SOAEmail)))
+	zone.WriteString(fmt.Sprintf("\t\t\t\t%d\t; serial\n", zoneFileSerial(domain, records)))
+	zone.WriteString(fmt.Sprintf("\t\t\t\t%d\t; refresh\n", withDefault(domain.RefreshSec, domainDefaultRefreshSec)))
+	zone.WriteString(fmt.Sprintf("\t\t\t\t%d\t; retry\n", withDefault(domain.RetrySec, domainDefaultRetrySec)))
+	zone.WriteString(fmt.Sprintf("\t\t\t\t%d\t; expire\n", withDefault(domain.ExpireSec, domainDefaultExpireSec)))
+	zone.WriteString(fmt.Sprintf("\t\t\t\t%d )\t; minimum\n", withDefault(domain.TTLSec, domainDefaultTTLSec)))
+	zone.WriteString("\n")
+	zone.Write(body.Bytes())
+
+	return zone.String()
+}
+
+// zoneFileSerial builds a YYYYMMDDnn serial from the latest update of the Domain or its records, in UTC,
+// where nn counts the day in steps of 864 seconds. The serial only increases as the zone changes, but
+// changes made within the same step, and deleted records, which leave no update behind, keep the serial.
+func zoneFileSerial(domain *linodego.Domain, records []*linodego.DomainRecord) int {
+	updated := domain.Updated
+	for _, record := range records {
+		if record.Updated.After(updated) {
+			updated = record.Updated
+		}
+	}
+	if updated.IsZero() {
+		return 1
+	}
+
+	updated = updated.UTC()
+	day := updated.Sub(updated.Truncate(24 * time.Hour))
+	serial, _ := strconv.Atoi(updated.Format("20060102"))
+	return serial*100 + int(day/(864*time.Second))
+}
+
+// zoneFileLine is a rendered record of a zone file
+type zoneFileLine struct {
+	name       string
+	ttl        int
+	recordType string
+	rdata      string
+}
+
+// less orders lines by name, with the origin first, then by type and data
+func (l zoneFileLine) less(other zoneFileLine) bool {
+	if l.name != other.name {
+		if l.name == "@" || other.name == "@" {
+			return l.name == "@"
+		}
+		return l.name < other.name
+	}
+	if l.recordType != other.recordType {
+		return l.recordType < other.recordType
+	}
+	if l.rdata != other.rdata {
+		return l.rdata < other.rdata
+	}
+	return l.ttl < other.ttl
+}
+
+// renderZoneFileRecord renders a Linode Domain Record as a line of a zone file
+func renderZoneFileRecord(record *linodego.DomainRecord) zoneFileLine {
+	fields := flattenDomainRecord(record)
+	line := zoneFileLine{name: record.Name, ttl: record.TTLSec, recordType: string(record.Type)}
+
+	switch record.Type {
+	case linodego.RecordTypeNS, linodego.RecordTypeCNAME:
+		line.rdata = zoneFileHostname(record.Target)
+	case linodego.RecordTypeMX:
+		line.rdata = fmt.Sprintf("%d %s", record.Priority, zoneFileHostname(record.Target))
+	case linodego.RecordTypeTXT:
+		line.rdata = zoneFileQuote(record.Target)
+	case linodego.RecordTypeSRV:
+		line.name = fmt.Sprintf("_%s._%s", fields["service"], fields["protocol"])
+		if record.Name != "" {
+			line.name += "." + record.Name
+		}
+		line.rdata = fmt.Sprintf("%d %d %d %s", record.Priority, record.Weight, record.Port, zoneFileHostname(record.Target))
+	case linodego.RecordTypeCAA:
+		line.rdata = fmt.Sprintf("0 %s %s", fields["tag"], zoneFileQuote(record.Target))
+	default:
+		line.rdata = record.Target
+	}
+
+	if line.name == "" {
+		line.name = "@"
+	}
+	return line
+}
+
+// zoneFileHostname makes the hostnames stored by Linode, which have no trailing dot, absolute
+func zoneFileHostname(hostname string) string {
+	if strings.Contains(hostname, ".") && !strings.HasSuffix(hostname, ".") {
+		return hostname + "."
+	}
+	return hostname
+}
+
+// zoneFileMailbox converts an email address into the mailbox of a SOA record
+func zoneFileMailbox(email string) string {
+	parts := strings.SplitN(email, "@", 2)
+	if len(parts) != 2 {
+		return zoneFileHostname(email)
+	}
+	return strings.Replace(parts[0], ".", "\\.", -1) + "." + zoneFileHostname(parts[1])
+}
+
+// zoneFileQuote quotes text, splitting it into strings of at most 255 characters
+func zoneFileQuote(text string) string {
+	escaped := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+	var parts []string
+	for len(text) > 255 {
+		parts = append(parts, `"`+escaped.Replace(text[:255])+`"`)
+		text = text[255:]
+	}
+	parts = append(parts, `"`+escaped.Replace(text)+`"`)
+	return strings.Join(parts, " ")
+}
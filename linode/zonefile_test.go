@@ -0,0 +1,214 @@
+package linode
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/chiefy/linodego"
+)
+
+const testZoneFile = `$ORIGIN example.com.
+$TTL 1h
+@	IN	SOA	ns1.example.com. hostmaster.example.com. (
+		2018080101 ; serial
+		7200       ; refresh
+		3600       ; retry
+		1209600    ; expire
+		3600 )     ; minimum
+	IN	NS	ns1.linode.com.
+	IN	MX	10 mail
+www	300	IN	A	192.0.2.1
+	IN	AAAA	2001:db8::1
+mail	IN	CNAME	www.example.com.
+@	IN	TXT	( "v=spf1 "
+		"include:example.net -all" )
+_sip._tcp	IN	SRV	10 5 5060 sip
+@	IN	CAA	0 issue "letsencrypt.org"
+$ORIGIN dev.example.com.
+api	1d	IN	A	192.0.2.2
+`
+
+func TestParseZoneFile(t *testing.T) {
+	t.Parallel()
+
+	records, warnings, err := parseZoneFile(testZoneFile, "example.com")
+	if err != nil {
+		t.Fatalf("unexpected error %s", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "SOA") {
+		t.Errorf("expected a warning about the SOA record, got %v", warnings)
+	}
+
+	expected := []struct {
+		recordType linodego.DomainRecordType
+		name       string
+		target     string
+		ttl        int
+	}{
+		{linodego.RecordTypeNS, "", "ns1.linode.com", 3600},
+		{linodego.RecordTypeMX, "", "mail.example.com", 3600},
+		{linodego.RecordTypeA, "www", "192.0.2.1", 300},
+		{linodego.RecordTypeAAAA, "www", "2001:db8::1", 3600},
+		{linodego.RecordTypeCNAME, "mail", "www.example.com", 3600},
+		{linodego.RecordTypeTXT, "", "v=spf1 include:example.net -all", 3600},
+		{linodego.RecordTypeSRV, "", "sip.example.com", 3600},
+		{linodego.RecordTypeCAA, "", "letsencrypt.org", 3600},
+		{linodego.RecordTypeA, "api.dev", "192.0.2.2", 86400},
+	}
+	if len(records) != len(expected) {
+		t.Fatalf("expected %d records, got %d", len(expected), len(records))
+	}
+	for i, e := range expected {
+		r := records[i]
+		if r.Type != e.recordType || r.Name != e.name || r.Target != e.target || r.TTLSec != e.ttl {
+			t.Errorf("expected %s %q %s %d, got %s %q %s %d", e.recordType, e.name, e.target, e.ttl, r.Type, r.Name, r.Target, r.TTLSec)
+		}
+	}
+
+	if mx := records[1]; mx.Priority != 10 {
+		t.Errorf("expected the MX preference to be 10, got %d", mx.Priority)
+	}
+	if srv := records[6]; *srv.Service != "sip" || *srv.Protocol != "tcp" || srv.Priority != 10 || srv.Weight != 5 || srv.Port != 5060 {
+		t.Errorf("unexpected SRV record %v", srv)
+	}
+	if caa := records[7]; *caa.Tag != "issue" {
+		t.Errorf("expected the CAA tag to be issue, got %s", *caa.Tag)
+	}
+}
+
+func TestParseZoneFileProblems(t *testing.T) {
+	t.Parallel()
+
+	zoneFile := `$INCLUDE other.zone
+www	IN	A	192.0.2.1
+1	IN	PTR	www.example.com.
+other.net.	IN	A	192.0.2.2
+ftp	IN	A	not-an-address
+@	IN	CAA	128 issue "letsencrypt.org"
+`
+
+	_, _, err := parseZoneFile(zoneFile, "example.com")
+	if err == nil {
+		t.Fatalf("expected the problems of the zone file to be reported")
+	}
+	for _, problem := range []string{"line 1: the $INCLUDE directive", "line 3: the PTR record type is not supported", "line 4: the name other.net. is outside", "line 5: the target of A records", "line 6: the flags of CAA records are not supported"} {
+		if !strings.Contains(err.Error(), problem) {
+			t.Errorf("expected %q to be reported, got %s", problem, err)
+		}
+	}
+}
+
+func TestParseZoneFileTTL(t *testing.T) {
+	t.Parallel()
+
+	cases := map[string]int{"300": 300, "1h": 3600, "1h30m": 5400, "2d": 172800, "1W": 604800}
+	for text, expected := range cases {
+		if ttl, err := parseZoneFileTTL(text); err != nil || ttl != expected {
+			t.Errorf("expected %s to be %d, got %d (%v)", text, expected, ttl, err)
+		}
+	}
+	for _, text := range []string{"", "IN", "1x", "h", "1h3"} {
+		if _, err := parseZoneFileTTL(text); err == nil {
+			t.Errorf("expected %q to be an invalid TTL", text)
+		}
+	}
+}
+
+func TestZoneFileSerial(t *testing.T) {
+	t.Parallel()
+
+	at := func(value string) time.Time {
+		updated, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return updated
+	}
+
+	domain := &linodego.Domain{Domain: "example.com", Updated: at("2018-06-01T10:00:00Z")}
+	records := []*linodego.DomainRecord{
+		{Type: linodego.RecordTypeA, Name: "www", Updated: at("2018-06-02T00:10:00Z")},
+		{Type: linodego.RecordTypeA, Name: "api", Updated: at("2018-05-30T23:59:59Z")},
+	}
+
+	cases := []struct {
+		name     string
+		domain   *linodego.Domain
+		records  []*linodego.DomainRecord
+		expected int
+	}{
+		{"no updates", &linodego.Domain{Domain: "example.com"}, nil, 1},
+		{"domain", domain, nil, 2018060141},
+		{"latest record", domain, records, 2018060200},
+		{"end of day", &linodego.Domain{Updated: at("2018-06-01T23:59:59Z")}, nil, 2018060199},
+		{"time zone", &linodego.Domain{Updated: at("2018-06-02T01:00:00+02:00")}, nil, 2018060195},
+	}
+
+	for _, c := range cases {
+		if serial := zoneFileSerial(c.domain, c.records); serial != c.expected {
+			t.Errorf("%s: expected the serial %d, got %d", c.name, c.expected, serial)
+		}
+	}
+
+	previous := zoneFileSerial(domain, records)
+	for _, updated := range []string{"2018-06-02T00:30:00Z", "2018-06-02T18:00:00Z", "2018-06-03T00:00:00Z", "2019-01-01T00:00:00Z"} {
+		records[1].Updated = at(updated)
+		serial := zoneFileSerial(domain, records)
+		if serial <= previous {
+			t.Errorf("expected the serial to increase after an update at %s, got %d after %d", updated, serial, previous)
+		}
+		previous = serial
+	}
+}
+
+func TestRenderZoneFile(t *testing.T) {
+	t.Parallel()
+
+	service, protocol, tag := "sip", "tcp", "issue"
+	domain := &linodego.Domain{Domain: "example.com", Type: linodego.DomainTypeMaster, SOAEmail: "host.master@example.com", TTLSec: 300}
+	records := []*linodego.DomainRecord{
+		{Type: linodego.RecordTypeA, Name: "www", Target: "192.0.2.1", Updated: time.Date(2018, 6, 1, 12, 0, 0, 0, time.UTC)},
+		{Type: linodego.RecordTypeTXT, Target: `v=spf1 "quoted" -all`, TTLSec: 3600},
+		{Type: linodego.RecordTypeMX, Target: "mail.example.com", Priority: 10},
+		{Type: linodego.RecordTypeSRV, Target: "sip.example.com", Service: &service, Protocol: &protocol, Priority: 10, Weight: 5, Port: 5060},
+		{Type: linodego.RecordTypeCAA, Target: "letsencrypt.org", Tag: &tag},
+		{Type: linodego.RecordTypeA, Name: "api", Target: "192.0.2.2"},
+	}
+
+	zone := renderZoneFile(domain, records)
+
+	for _, expected := range []string{
+		"$ORIGIN example.com.\n$TTL 300\n",
+		"IN\tSOA\tns1.linode.com. host\\.master.example.com. (",
+		"2018060150\t; serial",
+		"14400\t; refresh",
+		"1209600\t; expire",
+		"@\t3600\tIN\tTXT\t\"v=spf1 \\\"quoted\\\" -all\"\n",
+		"_sip._tcp\t\tIN\tSRV\t10 5 5060 sip.example.com.\n",
+	} {
+		if !strings.Contains(zone, expected) {
+			t.Errorf("expected the zone file to contain %q, got\n%s", expected, zone)
+		}
+	}
+
+	if strings.Index(zone, "@\t\tIN\tCAA") > strings.Index(zone, "api\t") || strings.Index(zone, "api\t") > strings.Index(zone, "www\t") {
+		t.Errorf("expected the records to be sorted by name, got\n%s", zone)
+	}
+	if shuffled := renderZoneFile(domain, []*linodego.DomainRecord{records[5], records[4], records[3], records[2], records[1], records[0]}); shuffled != zone {
+		t.Errorf("expected the zone file to be canonical, got\n%s\nand\n%s", zone, shuffled)
+	}
+
+	parsed, _, err := parseZoneFile(zone, domain.Domain)
+	if err != nil {
+		t.Fatalf("expected the rendered zone file to parse, got %s", err)
+	}
+	if len(parsed) != len(records)+len(linodeNameservers) {
+		t.Errorf("expected %d records after parsing the rendered zone file, got %d", len(records)+len(linodeNameservers), len(parsed))
+	}
+	for _, record := range parsed {
+		if record.Type == linodego.RecordTypeTXT && record.Target != records[1].Target {
+			t.Errorf("expected the TXT record to survive rendering, got %s", record.Target)
+		}
+	}
+}